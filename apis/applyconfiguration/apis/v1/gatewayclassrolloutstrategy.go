@@ -0,0 +1,52 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// GatewayClassRolloutStrategyApplyConfiguration represents an declarative configuration of the GatewayClassRolloutStrategy type for use
+// with apply.
+type GatewayClassRolloutStrategyApplyConfiguration struct {
+	Type         *v1.GatewayClassRolloutStrategyType `json:"type,omitempty"`
+	CanaryWeight *int32                              `json:"canaryWeight,omitempty"`
+}
+
+// GatewayClassRolloutStrategyApplyConfiguration constructs an declarative configuration of the GatewayClassRolloutStrategy type for use with
+// apply.
+func GatewayClassRolloutStrategy() *GatewayClassRolloutStrategyApplyConfiguration {
+	return &GatewayClassRolloutStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *GatewayClassRolloutStrategyApplyConfiguration) WithType(value v1.GatewayClassRolloutStrategyType) *GatewayClassRolloutStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithCanaryWeight sets the CanaryWeight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CanaryWeight field is set to the value of the last call.
+func (b *GatewayClassRolloutStrategyApplyConfiguration) WithCanaryWeight(value int32) *GatewayClassRolloutStrategyApplyConfiguration {
+	b.CanaryWeight = &value
+	return b
+}
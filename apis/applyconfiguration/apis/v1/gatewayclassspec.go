@@ -25,9 +25,10 @@ import (
 // GatewayClassSpecApplyConfiguration represents an declarative configuration of the GatewayClassSpec type for use
 // with apply.
 type GatewayClassSpecApplyConfiguration struct {
-	ControllerName *v1.GatewayController                  `json:"controllerName,omitempty"`
-	ParametersRef  *ParametersReferenceApplyConfiguration `json:"parametersRef,omitempty"`
-	Description    *string                                `json:"description,omitempty"`
+	ControllerName  *v1.GatewayController                          `json:"controllerName,omitempty"`
+	ParametersRef   *ParametersReferenceApplyConfiguration         `json:"parametersRef,omitempty"`
+	Description     *string                                        `json:"description,omitempty"`
+	RolloutStrategy *GatewayClassRolloutStrategyApplyConfiguration `json:"rolloutStrategy,omitempty"`
 }
 
 // GatewayClassSpecApplyConfiguration constructs an declarative configuration of the GatewayClassSpec type for use with
@@ -59,3 +60,11 @@ func (b *GatewayClassSpecApplyConfiguration) WithDescription(value string) *Gate
 	b.Description = &value
 	return b
 }
+
+// WithRolloutStrategy sets the RolloutStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolloutStrategy field is set to the value of the last call.
+func (b *GatewayClassSpecApplyConfiguration) WithRolloutStrategy(value *GatewayClassRolloutStrategyApplyConfiguration) *GatewayClassSpecApplyConfiguration {
+	b.RolloutStrategy = value
+	return b
+}
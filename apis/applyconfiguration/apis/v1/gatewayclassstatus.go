@@ -20,14 +20,17 @@ package v1
 
 import (
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // GatewayClassStatusApplyConfiguration represents an declarative configuration of the GatewayClassStatus type for use
 // with apply.
 type GatewayClassStatusApplyConfiguration struct {
-	Conditions        []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
-	SupportedFeatures []apisv1.SupportedFeature        `json:"supportedFeatures,omitempty"`
+	Conditions        []v1.ConditionApplyConfiguration             `json:"conditions,omitempty"`
+	SupportedFeatures []apisv1.SupportedFeature                    `json:"supportedFeatures,omitempty"`
+	ControllerVersion *string                                      `json:"controllerVersion,omitempty"`
+	RolloutStatus     *GatewayClassRolloutStatusApplyConfiguration `json:"rolloutStatus,omitempty"`
 }
 
 // GatewayClassStatusApplyConfiguration constructs an declarative configuration of the GatewayClassStatus type for use with
@@ -58,3 +61,19 @@ func (b *GatewayClassStatusApplyConfiguration) WithSupportedFeatures(values ...a
 	}
 	return b
 }
+
+// WithControllerVersion sets the ControllerVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControllerVersion field is set to the value of the last call.
+func (b *GatewayClassStatusApplyConfiguration) WithControllerVersion(value string) *GatewayClassStatusApplyConfiguration {
+	b.ControllerVersion = &value
+	return b
+}
+
+// WithRolloutStatus sets the RolloutStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolloutStatus field is set to the value of the last call.
+func (b *GatewayClassStatusApplyConfiguration) WithRolloutStatus(value *GatewayClassRolloutStatusApplyConfiguration) *GatewayClassStatusApplyConfiguration {
+	b.RolloutStatus = value
+	return b
+}
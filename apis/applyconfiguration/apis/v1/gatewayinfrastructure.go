@@ -25,9 +25,10 @@ import (
 // GatewayInfrastructureApplyConfiguration represents an declarative configuration of the GatewayInfrastructure type for use
 // with apply.
 type GatewayInfrastructureApplyConfiguration struct {
-	Labels        map[v1.AnnotationKey]v1.AnnotationValue     `json:"labels,omitempty"`
-	Annotations   map[v1.AnnotationKey]v1.AnnotationValue     `json:"annotations,omitempty"`
-	ParametersRef *LocalParametersReferenceApplyConfiguration `json:"parametersRef,omitempty"`
+	Labels              map[v1.AnnotationKey]v1.AnnotationValue     `json:"labels,omitempty"`
+	Annotations         map[v1.AnnotationKey]v1.AnnotationValue     `json:"annotations,omitempty"`
+	ResourceAnnotations map[v1.AnnotationKey]v1.AnnotationValue     `json:"resourceAnnotations,omitempty"`
+	ParametersRef       *LocalParametersReferenceApplyConfiguration `json:"parametersRef,omitempty"`
 }
 
 // GatewayInfrastructureApplyConfiguration constructs an declarative configuration of the GatewayInfrastructure type for use with
@@ -64,6 +65,20 @@ func (b *GatewayInfrastructureApplyConfiguration) WithAnnotations(entries map[v1
 	return b
 }
 
+// WithResourceAnnotations puts the entries into the ResourceAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ResourceAnnotations field,
+// overwriting an existing map entries in ResourceAnnotations field with the same key.
+func (b *GatewayInfrastructureApplyConfiguration) WithResourceAnnotations(entries map[v1.AnnotationKey]v1.AnnotationValue) *GatewayInfrastructureApplyConfiguration {
+	if b.ResourceAnnotations == nil && len(entries) > 0 {
+		b.ResourceAnnotations = make(map[v1.AnnotationKey]v1.AnnotationValue, len(entries))
+	}
+	for k, v := range entries {
+		b.ResourceAnnotations[k] = v
+	}
+	return b
+}
+
 // WithParametersRef sets the ParametersRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ParametersRef field is set to the value of the last call.
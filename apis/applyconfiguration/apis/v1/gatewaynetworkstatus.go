@@ -0,0 +1,57 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// GatewayNetworkStatusApplyConfiguration represents an declarative configuration of the GatewayNetworkStatus type for use
+// with apply.
+type GatewayNetworkStatusApplyConfiguration struct {
+	CloudResourceID *string `json:"cloudResourceID,omitempty"`
+	Region          *string `json:"region,omitempty"`
+	Zone            *string `json:"zone,omitempty"`
+}
+
+// GatewayNetworkStatusApplyConfiguration constructs an declarative configuration of the GatewayNetworkStatus type for use with
+// apply.
+func GatewayNetworkStatus() *GatewayNetworkStatusApplyConfiguration {
+	return &GatewayNetworkStatusApplyConfiguration{}
+}
+
+// WithCloudResourceID sets the CloudResourceID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CloudResourceID field is set to the value of the last call.
+func (b *GatewayNetworkStatusApplyConfiguration) WithCloudResourceID(value string) *GatewayNetworkStatusApplyConfiguration {
+	b.CloudResourceID = &value
+	return b
+}
+
+// WithRegion sets the Region field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Region field is set to the value of the last call.
+func (b *GatewayNetworkStatusApplyConfiguration) WithRegion(value string) *GatewayNetworkStatusApplyConfiguration {
+	b.Region = &value
+	return b
+}
+
+// WithZone sets the Zone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Zone field is set to the value of the last call.
+func (b *GatewayNetworkStatusApplyConfiguration) WithZone(value string) *GatewayNetworkStatusApplyConfiguration {
+	b.Zone = &value
+	return b
+}
@@ -25,9 +25,10 @@ import (
 // GatewayStatusApplyConfiguration represents an declarative configuration of the GatewayStatus type for use
 // with apply.
 type GatewayStatusApplyConfiguration struct {
-	Addresses  []GatewayStatusAddressApplyConfiguration `json:"addresses,omitempty"`
-	Conditions []metav1.ConditionApplyConfiguration     `json:"conditions,omitempty"`
-	Listeners  []ListenerStatusApplyConfiguration       `json:"listeners,omitempty"`
+	Addresses     []GatewayStatusAddressApplyConfiguration `json:"addresses,omitempty"`
+	Conditions    []metav1.ConditionApplyConfiguration     `json:"conditions,omitempty"`
+	Listeners     []ListenerStatusApplyConfiguration       `json:"listeners,omitempty"`
+	NetworkStatus *GatewayNetworkStatusApplyConfiguration  `json:"networkStatus,omitempty"`
 }
 
 // GatewayStatusApplyConfiguration constructs an declarative configuration of the GatewayStatus type for use with
@@ -74,3 +75,11 @@ func (b *GatewayStatusApplyConfiguration) WithListeners(values ...*ListenerStatu
 	}
 	return b
 }
+
+// WithNetworkStatus sets the NetworkStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkStatus field is set to the value of the last call.
+func (b *GatewayStatusApplyConfiguration) WithNetworkStatus(value *GatewayNetworkStatusApplyConfiguration) *GatewayStatusApplyConfiguration {
+	b.NetworkStatus = value
+	return b
+}
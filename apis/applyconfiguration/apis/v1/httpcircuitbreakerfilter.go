@@ -0,0 +1,57 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// HTTPCircuitBreakerFilterApplyConfiguration represents an declarative configuration of the HTTPCircuitBreakerFilter type for use
+// with apply.
+type HTTPCircuitBreakerFilterApplyConfiguration struct {
+	MaxFailures     *int32 `json:"maxFailures,omitempty"`
+	WindowSeconds   *int32 `json:"windowSeconds,omitempty"`
+	CooldownSeconds *int32 `json:"cooldownSeconds,omitempty"`
+}
+
+// HTTPCircuitBreakerFilterApplyConfiguration constructs an declarative configuration of the HTTPCircuitBreakerFilter type for use with
+// apply.
+func HTTPCircuitBreakerFilter() *HTTPCircuitBreakerFilterApplyConfiguration {
+	return &HTTPCircuitBreakerFilterApplyConfiguration{}
+}
+
+// WithMaxFailures sets the MaxFailures field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxFailures field is set to the value of the last call.
+func (b *HTTPCircuitBreakerFilterApplyConfiguration) WithMaxFailures(value int32) *HTTPCircuitBreakerFilterApplyConfiguration {
+	b.MaxFailures = &value
+	return b
+}
+
+// WithWindowSeconds sets the WindowSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WindowSeconds field is set to the value of the last call.
+func (b *HTTPCircuitBreakerFilterApplyConfiguration) WithWindowSeconds(value int32) *HTTPCircuitBreakerFilterApplyConfiguration {
+	b.WindowSeconds = &value
+	return b
+}
+
+// WithCooldownSeconds sets the CooldownSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CooldownSeconds field is set to the value of the last call.
+func (b *HTTPCircuitBreakerFilterApplyConfiguration) WithCooldownSeconds(value int32) *HTTPCircuitBreakerFilterApplyConfiguration {
+	b.CooldownSeconds = &value
+	return b
+}
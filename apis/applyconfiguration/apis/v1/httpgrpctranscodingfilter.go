@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// HTTPGRPCTranscodingFilterApplyConfiguration represents an declarative configuration of the HTTPGRPCTranscodingFilter type for use
+// with apply.
+type HTTPGRPCTranscodingFilterApplyConfiguration struct {
+	ProtoDescriptorRef           *LocalObjectReferenceApplyConfiguration `json:"protoDescriptorRef,omitempty"`
+	IgnoreUnknownQueryParameters *bool                                   `json:"ignoreUnknownQueryParameters,omitempty"`
+}
+
+// HTTPGRPCTranscodingFilterApplyConfiguration constructs an declarative configuration of the HTTPGRPCTranscodingFilter type for use with
+// apply.
+func HTTPGRPCTranscodingFilter() *HTTPGRPCTranscodingFilterApplyConfiguration {
+	return &HTTPGRPCTranscodingFilterApplyConfiguration{}
+}
+
+// WithProtoDescriptorRef sets the ProtoDescriptorRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProtoDescriptorRef field is set to the value of the last call.
+func (b *HTTPGRPCTranscodingFilterApplyConfiguration) WithProtoDescriptorRef(value *LocalObjectReferenceApplyConfiguration) *HTTPGRPCTranscodingFilterApplyConfiguration {
+	b.ProtoDescriptorRef = value
+	return b
+}
+
+// WithIgnoreUnknownQueryParameters sets the IgnoreUnknownQueryParameters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IgnoreUnknownQueryParameters field is set to the value of the last call.
+func (b *HTTPGRPCTranscodingFilterApplyConfiguration) WithIgnoreUnknownQueryParameters(value bool) *HTTPGRPCTranscodingFilterApplyConfiguration {
+	b.IgnoreUnknownQueryParameters = &value
+	return b
+}
@@ -0,0 +1,81 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// HTTPJWTAuthFilterApplyConfiguration represents an declarative configuration of the HTTPJWTAuthFilter type for use
+// with apply.
+type HTTPJWTAuthFilterApplyConfiguration struct {
+	Issuer       *string            `json:"issuer,omitempty"`
+	Audiences    []string           `json:"audiences,omitempty"`
+	JWKSURI      *string            `json:"jwksUri,omitempty"`
+	HeaderName   *v1.HTTPHeaderName `json:"headerName,omitempty"`
+	ForwardToken *bool              `json:"forwardToken,omitempty"`
+}
+
+// HTTPJWTAuthFilterApplyConfiguration constructs an declarative configuration of the HTTPJWTAuthFilter type for use with
+// apply.
+func HTTPJWTAuthFilter() *HTTPJWTAuthFilterApplyConfiguration {
+	return &HTTPJWTAuthFilterApplyConfiguration{}
+}
+
+// WithIssuer sets the Issuer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Issuer field is set to the value of the last call.
+func (b *HTTPJWTAuthFilterApplyConfiguration) WithIssuer(value string) *HTTPJWTAuthFilterApplyConfiguration {
+	b.Issuer = &value
+	return b
+}
+
+// WithAudiences adds the given value to the Audiences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Audiences field.
+func (b *HTTPJWTAuthFilterApplyConfiguration) WithAudiences(values ...string) *HTTPJWTAuthFilterApplyConfiguration {
+	for i := range values {
+		b.Audiences = append(b.Audiences, values[i])
+	}
+	return b
+}
+
+// WithJWKSURI sets the JWKSURI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWKSURI field is set to the value of the last call.
+func (b *HTTPJWTAuthFilterApplyConfiguration) WithJWKSURI(value string) *HTTPJWTAuthFilterApplyConfiguration {
+	b.JWKSURI = &value
+	return b
+}
+
+// WithHeaderName sets the HeaderName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeaderName field is set to the value of the last call.
+func (b *HTTPJWTAuthFilterApplyConfiguration) WithHeaderName(value v1.HTTPHeaderName) *HTTPJWTAuthFilterApplyConfiguration {
+	b.HeaderName = &value
+	return b
+}
+
+// WithForwardToken sets the ForwardToken field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ForwardToken field is set to the value of the last call.
+func (b *HTTPJWTAuthFilterApplyConfiguration) WithForwardToken(value bool) *HTTPJWTAuthFilterApplyConfiguration {
+	b.ForwardToken = &value
+	return b
+}
@@ -25,8 +25,10 @@ import (
 // HTTPPathMatchApplyConfiguration represents an declarative configuration of the HTTPPathMatch type for use
 // with apply.
 type HTTPPathMatchApplyConfiguration struct {
-	Type  *v1.PathMatchType `json:"type,omitempty"`
-	Value *string           `json:"value,omitempty"`
+	Type                   *v1.PathMatchType `json:"type,omitempty"`
+	Value                  *string           `json:"value,omitempty"`
+	NormalizeTrailingSlash *bool             `json:"normalizeTrailingSlash,omitempty"`
+	CaseInsensitive        *bool             `json:"caseInsensitive,omitempty"`
 }
 
 // HTTPPathMatchApplyConfiguration constructs an declarative configuration of the HTTPPathMatch type for use with
@@ -50,3 +52,19 @@ func (b *HTTPPathMatchApplyConfiguration) WithValue(value string) *HTTPPathMatch
 	b.Value = &value
 	return b
 }
+
+// WithNormalizeTrailingSlash sets the NormalizeTrailingSlash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NormalizeTrailingSlash field is set to the value of the last call.
+func (b *HTTPPathMatchApplyConfiguration) WithNormalizeTrailingSlash(value bool) *HTTPPathMatchApplyConfiguration {
+	b.NormalizeTrailingSlash = &value
+	return b
+}
+
+// WithCaseInsensitive sets the CaseInsensitive field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CaseInsensitive field is set to the value of the last call.
+func (b *HTTPPathMatchApplyConfiguration) WithCaseInsensitive(value bool) *HTTPPathMatchApplyConfiguration {
+	b.CaseInsensitive = &value
+	return b
+}
@@ -22,6 +22,7 @@ package v1
 // with apply.
 type HTTPRequestMirrorFilterApplyConfiguration struct {
 	BackendRef *BackendObjectReferenceApplyConfiguration `json:"backendRef,omitempty"`
+	Percent    *int32                                    `json:"percent,omitempty"`
 }
 
 // HTTPRequestMirrorFilterApplyConfiguration constructs an declarative configuration of the HTTPRequestMirrorFilter type for use with
@@ -37,3 +38,11 @@ func (b *HTTPRequestMirrorFilterApplyConfiguration) WithBackendRef(value *Backen
 	b.BackendRef = value
 	return b
 }
+
+// WithPercent sets the Percent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Percent field is set to the value of the last call.
+func (b *HTTPRequestMirrorFilterApplyConfiguration) WithPercent(value int32) *HTTPRequestMirrorFilterApplyConfiguration {
+	b.Percent = &value
+	return b
+}
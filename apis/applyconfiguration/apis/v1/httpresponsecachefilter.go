@@ -0,0 +1,85 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// HTTPResponseCacheFilterApplyConfiguration represents an declarative configuration of the HTTPResponseCacheFilter type for use
+// with apply.
+type HTTPResponseCacheFilterApplyConfiguration struct {
+	TTL                  *v1.Duration        `json:"ttl,omitempty"`
+	VaryHeaders          []v1.HTTPHeaderName `json:"varyHeaders,omitempty"`
+	Methods              []v1.HTTPMethod     `json:"methods,omitempty"`
+	PrivateHeaders       []v1.HTTPHeaderName `json:"privateHeaders,omitempty"`
+	MaxResponseBodyBytes *int64              `json:"maxResponseBodyBytes,omitempty"`
+}
+
+// HTTPResponseCacheFilterApplyConfiguration constructs an declarative configuration of the HTTPResponseCacheFilter type for use with
+// apply.
+func HTTPResponseCacheFilter() *HTTPResponseCacheFilterApplyConfiguration {
+	return &HTTPResponseCacheFilterApplyConfiguration{}
+}
+
+// WithTTL sets the TTL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTL field is set to the value of the last call.
+func (b *HTTPResponseCacheFilterApplyConfiguration) WithTTL(value v1.Duration) *HTTPResponseCacheFilterApplyConfiguration {
+	b.TTL = &value
+	return b
+}
+
+// WithVaryHeaders adds the given value to the VaryHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VaryHeaders field.
+func (b *HTTPResponseCacheFilterApplyConfiguration) WithVaryHeaders(values ...v1.HTTPHeaderName) *HTTPResponseCacheFilterApplyConfiguration {
+	for i := range values {
+		b.VaryHeaders = append(b.VaryHeaders, values[i])
+	}
+	return b
+}
+
+// WithMethods adds the given value to the Methods field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Methods field.
+func (b *HTTPResponseCacheFilterApplyConfiguration) WithMethods(values ...v1.HTTPMethod) *HTTPResponseCacheFilterApplyConfiguration {
+	for i := range values {
+		b.Methods = append(b.Methods, values[i])
+	}
+	return b
+}
+
+// WithPrivateHeaders adds the given value to the PrivateHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PrivateHeaders field.
+func (b *HTTPResponseCacheFilterApplyConfiguration) WithPrivateHeaders(values ...v1.HTTPHeaderName) *HTTPResponseCacheFilterApplyConfiguration {
+	for i := range values {
+		b.PrivateHeaders = append(b.PrivateHeaders, values[i])
+	}
+	return b
+}
+
+// WithMaxResponseBodyBytes sets the MaxResponseBodyBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxResponseBodyBytes field is set to the value of the last call.
+func (b *HTTPResponseCacheFilterApplyConfiguration) WithMaxResponseBodyBytes(value int64) *HTTPResponseCacheFilterApplyConfiguration {
+	b.MaxResponseBodyBytes = &value
+	return b
+}
@@ -0,0 +1,65 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// HTTPResponseCompressionFilterApplyConfiguration represents an declarative configuration of the HTTPResponseCompressionFilter type for use
+// with apply.
+type HTTPResponseCompressionFilterApplyConfiguration struct {
+	Encodings           []v1.CompressionEncoding `json:"encodings,omitempty"`
+	MinSizeBytes        *int64                   `json:"minSizeBytes,omitempty"`
+	ExcludeContentTypes []string                 `json:"excludeContentTypes,omitempty"`
+}
+
+// HTTPResponseCompressionFilterApplyConfiguration constructs an declarative configuration of the HTTPResponseCompressionFilter type for use with
+// apply.
+func HTTPResponseCompressionFilter() *HTTPResponseCompressionFilterApplyConfiguration {
+	return &HTTPResponseCompressionFilterApplyConfiguration{}
+}
+
+// WithEncodings adds the given value to the Encodings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Encodings field.
+func (b *HTTPResponseCompressionFilterApplyConfiguration) WithEncodings(values ...v1.CompressionEncoding) *HTTPResponseCompressionFilterApplyConfiguration {
+	for i := range values {
+		b.Encodings = append(b.Encodings, values[i])
+	}
+	return b
+}
+
+// WithMinSizeBytes sets the MinSizeBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinSizeBytes field is set to the value of the last call.
+func (b *HTTPResponseCompressionFilterApplyConfiguration) WithMinSizeBytes(value int64) *HTTPResponseCompressionFilterApplyConfiguration {
+	b.MinSizeBytes = &value
+	return b
+}
+
+// WithExcludeContentTypes adds the given value to the ExcludeContentTypes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExcludeContentTypes field.
+func (b *HTTPResponseCompressionFilterApplyConfiguration) WithExcludeContentTypes(values ...string) *HTTPResponseCompressionFilterApplyConfiguration {
+	for i := range values {
+		b.ExcludeContentTypes = append(b.ExcludeContentTypes, values[i])
+	}
+	return b
+}
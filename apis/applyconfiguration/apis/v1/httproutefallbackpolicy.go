@@ -0,0 +1,54 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// HTTPRouteFallbackPolicyApplyConfiguration represents an declarative configuration of the HTTPRouteFallbackPolicy type for use
+// with apply.
+type HTTPRouteFallbackPolicyApplyConfiguration struct {
+	StatusCodes []v1.HTTPRouteFallbackStatusCode `json:"statusCodes,omitempty"`
+	MaxRetries  *int32                           `json:"maxRetries,omitempty"`
+}
+
+// HTTPRouteFallbackPolicyApplyConfiguration constructs an declarative configuration of the HTTPRouteFallbackPolicy type for use with
+// apply.
+func HTTPRouteFallbackPolicy() *HTTPRouteFallbackPolicyApplyConfiguration {
+	return &HTTPRouteFallbackPolicyApplyConfiguration{}
+}
+
+// WithStatusCodes adds the given value to the StatusCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StatusCodes field.
+func (b *HTTPRouteFallbackPolicyApplyConfiguration) WithStatusCodes(values ...v1.HTTPRouteFallbackStatusCode) *HTTPRouteFallbackPolicyApplyConfiguration {
+	for i := range values {
+		b.StatusCodes = append(b.StatusCodes, values[i])
+	}
+	return b
+}
+
+// WithMaxRetries sets the MaxRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetries field is set to the value of the last call.
+func (b *HTTPRouteFallbackPolicyApplyConfiguration) WithMaxRetries(value int32) *HTTPRouteFallbackPolicyApplyConfiguration {
+	b.MaxRetries = &value
+	return b
+}
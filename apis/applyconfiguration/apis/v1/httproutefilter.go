@@ -25,13 +25,19 @@ import (
 // HTTPRouteFilterApplyConfiguration represents an declarative configuration of the HTTPRouteFilter type for use
 // with apply.
 type HTTPRouteFilterApplyConfiguration struct {
-	Type                   *v1.HTTPRouteFilterType                      `json:"type,omitempty"`
-	RequestHeaderModifier  *HTTPHeaderFilterApplyConfiguration          `json:"requestHeaderModifier,omitempty"`
-	ResponseHeaderModifier *HTTPHeaderFilterApplyConfiguration          `json:"responseHeaderModifier,omitempty"`
-	RequestMirror          *HTTPRequestMirrorFilterApplyConfiguration   `json:"requestMirror,omitempty"`
-	RequestRedirect        *HTTPRequestRedirectFilterApplyConfiguration `json:"requestRedirect,omitempty"`
-	URLRewrite             *HTTPURLRewriteFilterApplyConfiguration      `json:"urlRewrite,omitempty"`
-	ExtensionRef           *LocalObjectReferenceApplyConfiguration      `json:"extensionRef,omitempty"`
+	Type                   *v1.HTTPRouteFilterType                          `json:"type,omitempty"`
+	RequestHeaderModifier  *HTTPHeaderFilterApplyConfiguration              `json:"requestHeaderModifier,omitempty"`
+	ResponseHeaderModifier *HTTPHeaderFilterApplyConfiguration              `json:"responseHeaderModifier,omitempty"`
+	RequestMirror          *HTTPRequestMirrorFilterApplyConfiguration       `json:"requestMirror,omitempty"`
+	RequestRedirect        *HTTPRequestRedirectFilterApplyConfiguration     `json:"requestRedirect,omitempty"`
+	URLRewrite             *HTTPURLRewriteFilterApplyConfiguration          `json:"urlRewrite,omitempty"`
+	ExtensionRef           *LocalObjectReferenceApplyConfiguration          `json:"extensionRef,omitempty"`
+	RequestBodyLimit       *HTTPRequestBodyLimitFilterApplyConfiguration    `json:"requestBodyLimit,omitempty"`
+	ResponseCompression    *HTTPResponseCompressionFilterApplyConfiguration `json:"responseCompression,omitempty"`
+	JWTAuth                *HTTPJWTAuthFilterApplyConfiguration             `json:"jwtAuth,omitempty"`
+	CircuitBreaker         *HTTPCircuitBreakerFilterApplyConfiguration      `json:"circuitBreaker,omitempty"`
+	ResponseCache          *HTTPResponseCacheFilterApplyConfiguration       `json:"responseCache,omitempty"`
+	GRPCTranscoding        *HTTPGRPCTranscodingFilterApplyConfiguration     `json:"grpcTranscoding,omitempty"`
 }
 
 // HTTPRouteFilterApplyConfiguration constructs an declarative configuration of the HTTPRouteFilter type for use with
@@ -95,3 +101,51 @@ func (b *HTTPRouteFilterApplyConfiguration) WithExtensionRef(value *LocalObjectR
 	b.ExtensionRef = value
 	return b
 }
+
+// WithRequestBodyLimit sets the RequestBodyLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestBodyLimit field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithRequestBodyLimit(value *HTTPRequestBodyLimitFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.RequestBodyLimit = value
+	return b
+}
+
+// WithResponseCompression sets the ResponseCompression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseCompression field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithResponseCompression(value *HTTPResponseCompressionFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.ResponseCompression = value
+	return b
+}
+
+// WithJWTAuth sets the JWTAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWTAuth field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithJWTAuth(value *HTTPJWTAuthFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.JWTAuth = value
+	return b
+}
+
+// WithCircuitBreaker sets the CircuitBreaker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CircuitBreaker field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithCircuitBreaker(value *HTTPCircuitBreakerFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.CircuitBreaker = value
+	return b
+}
+
+// WithResponseCache sets the ResponseCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseCache field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithResponseCache(value *HTTPResponseCacheFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.ResponseCache = value
+	return b
+}
+
+// WithGRPCTranscoding sets the GRPCTranscoding field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GRPCTranscoding field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithGRPCTranscoding(value *HTTPGRPCTranscodingFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.GRPCTranscoding = value
+	return b
+}
@@ -29,6 +29,7 @@ type HTTPRouteMatchApplyConfiguration struct {
 	Headers     []HTTPHeaderMatchApplyConfiguration     `json:"headers,omitempty"`
 	QueryParams []HTTPQueryParamMatchApplyConfiguration `json:"queryParams,omitempty"`
 	Method      *apisv1.HTTPMethod                      `json:"method,omitempty"`
+	Upgrade     *apisv1.HTTPUpgradeType                 `json:"upgrade,omitempty"`
 }
 
 // HTTPRouteMatchApplyConfiguration constructs an declarative configuration of the HTTPRouteMatch type for use with
@@ -78,3 +79,11 @@ func (b *HTTPRouteMatchApplyConfiguration) WithMethod(value apisv1.HTTPMethod) *
 	b.Method = &value
 	return b
 }
+
+// WithUpgrade sets the Upgrade field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Upgrade field is set to the value of the last call.
+func (b *HTTPRouteMatchApplyConfiguration) WithUpgrade(value apisv1.HTTPUpgradeType) *HTTPRouteMatchApplyConfiguration {
+	b.Upgrade = &value
+	return b
+}
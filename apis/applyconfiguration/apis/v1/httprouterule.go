@@ -18,14 +18,22 @@ limitations under the License.
 
 package v1
 
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
 // HTTPRouteRuleApplyConfiguration represents an declarative configuration of the HTTPRouteRule type for use
 // with apply.
 type HTTPRouteRuleApplyConfiguration struct {
-	Matches            []HTTPRouteMatchApplyConfiguration    `json:"matches,omitempty"`
-	Filters            []HTTPRouteFilterApplyConfiguration   `json:"filters,omitempty"`
-	BackendRefs        []HTTPBackendRefApplyConfiguration    `json:"backendRefs,omitempty"`
-	Timeouts           *HTTPRouteTimeoutsApplyConfiguration  `json:"timeouts,omitempty"`
-	SessionPersistence *SessionPersistenceApplyConfiguration `json:"sessionPersistence,omitempty"`
+	Name                 *v1.SectionName                            `json:"name,omitempty"`
+	Matches              []HTTPRouteMatchApplyConfiguration         `json:"matches,omitempty"`
+	Filters              []HTTPRouteFilterApplyConfiguration        `json:"filters,omitempty"`
+	BackendRefs          []HTTPBackendRefApplyConfiguration         `json:"backendRefs,omitempty"`
+	Timeouts             *HTTPRouteTimeoutsApplyConfiguration       `json:"timeouts,omitempty"`
+	SessionPersistence   *SessionPersistenceApplyConfiguration      `json:"sessionPersistence,omitempty"`
+	FallbackPolicy       *HTTPRouteFallbackPolicyApplyConfiguration `json:"fallbackPolicy,omitempty"`
+	BackendOverrides     []BackendWeightOverrideApplyConfiguration  `json:"backendOverrides,omitempty"`
+	AutoWeightAdjustment *bool                                      `json:"autoWeightAdjustment,omitempty"`
 }
 
 // HTTPRouteRuleApplyConfiguration constructs an declarative configuration of the HTTPRouteRule type for use with
@@ -34,6 +42,14 @@ func HTTPRouteRule() *HTTPRouteRuleApplyConfiguration {
 	return &HTTPRouteRuleApplyConfiguration{}
 }
 
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *HTTPRouteRuleApplyConfiguration) WithName(value v1.SectionName) *HTTPRouteRuleApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
 // WithMatches adds the given value to the Matches field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Matches field.
@@ -88,3 +104,32 @@ func (b *HTTPRouteRuleApplyConfiguration) WithSessionPersistence(value *SessionP
 	b.SessionPersistence = value
 	return b
 }
+
+// WithFallbackPolicy sets the FallbackPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FallbackPolicy field is set to the value of the last call.
+func (b *HTTPRouteRuleApplyConfiguration) WithFallbackPolicy(value *HTTPRouteFallbackPolicyApplyConfiguration) *HTTPRouteRuleApplyConfiguration {
+	b.FallbackPolicy = value
+	return b
+}
+
+// WithBackendOverrides adds the given value to the BackendOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the BackendOverrides field.
+func (b *HTTPRouteRuleApplyConfiguration) WithBackendOverrides(values ...*BackendWeightOverrideApplyConfiguration) *HTTPRouteRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBackendOverrides")
+		}
+		b.BackendOverrides = append(b.BackendOverrides, *values[i])
+	}
+	return b
+}
+
+// WithAutoWeightAdjustment sets the AutoWeightAdjustment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoWeightAdjustment field is set to the value of the last call.
+func (b *HTTPRouteRuleApplyConfiguration) WithAutoWeightAdjustment(value bool) *HTTPRouteRuleApplyConfiguration {
+	b.AutoWeightAdjustment = &value
+	return b
+}
@@ -27,6 +27,7 @@ import (
 type HTTPRouteSpecApplyConfiguration struct {
 	CommonRouteSpecApplyConfiguration `json:",inline"`
 	Hostnames                         []apisv1.Hostname                 `json:"hostnames,omitempty"`
+	Priority                          *int32                            `json:"priority,omitempty"`
 	Rules                             []HTTPRouteRuleApplyConfiguration `json:"rules,omitempty"`
 }
 
@@ -59,6 +60,14 @@ func (b *HTTPRouteSpecApplyConfiguration) WithHostnames(values ...apisv1.Hostnam
 	return b
 }
 
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *HTTPRouteSpecApplyConfiguration) WithPriority(value int32) *HTTPRouteSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
 // WithRules adds the given value to the Rules field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Rules field.
@@ -25,12 +25,13 @@ import (
 // ListenerApplyConfiguration represents an declarative configuration of the Listener type for use
 // with apply.
 type ListenerApplyConfiguration struct {
-	Name          *v1.SectionName                     `json:"name,omitempty"`
-	Hostname      *v1.Hostname                        `json:"hostname,omitempty"`
-	Port          *v1.PortNumber                      `json:"port,omitempty"`
-	Protocol      *v1.ProtocolType                    `json:"protocol,omitempty"`
-	TLS           *GatewayTLSConfigApplyConfiguration `json:"tls,omitempty"`
-	AllowedRoutes *AllowedRoutesApplyConfiguration    `json:"allowedRoutes,omitempty"`
+	Name           *v1.SectionName                     `json:"name,omitempty"`
+	Hostname       *v1.Hostname                        `json:"hostname,omitempty"`
+	Port           *v1.PortNumber                      `json:"port,omitempty"`
+	Protocol       *v1.ProtocolType                    `json:"protocol,omitempty"`
+	TLS            *GatewayTLSConfigApplyConfiguration `json:"tls,omitempty"`
+	AllowedRoutes  *AllowedRoutesApplyConfiguration    `json:"allowedRoutes,omitempty"`
+	MaxConnections *int64                              `json:"maxConnections,omitempty"`
 }
 
 // ListenerApplyConfiguration constructs an declarative configuration of the Listener type for use with
@@ -86,3 +87,11 @@ func (b *ListenerApplyConfiguration) WithAllowedRoutes(value *AllowedRoutesApply
 	b.AllowedRoutes = value
 	return b
 }
+
+// WithMaxConnections sets the MaxConnections field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxConnections field is set to the value of the last call.
+func (b *ListenerApplyConfiguration) WithMaxConnections(value int64) *ListenerApplyConfiguration {
+	b.MaxConnections = &value
+	return b
+}
@@ -27,9 +27,11 @@ import (
 // RouteParentStatusApplyConfiguration represents an declarative configuration of the RouteParentStatus type for use
 // with apply.
 type RouteParentStatusApplyConfiguration struct {
-	ParentRef      *ParentReferenceApplyConfiguration   `json:"parentRef,omitempty"`
-	ControllerName *apisv1.GatewayController            `json:"controllerName,omitempty"`
-	Conditions     []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	ParentRef        *ParentReferenceApplyConfiguration   `json:"parentRef,omitempty"`
+	ControllerName   *apisv1.GatewayController            `json:"controllerName,omitempty"`
+	Conditions       []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	ResolvedBackends *int32                               `json:"resolvedBackends,omitempty"`
+	TotalBackends    *int32                               `json:"totalBackends,omitempty"`
 }
 
 // RouteParentStatusApplyConfiguration constructs an declarative configuration of the RouteParentStatus type for use with
@@ -66,3 +68,19 @@ func (b *RouteParentStatusApplyConfiguration) WithConditions(values ...*metav1.C
 	}
 	return b
 }
+
+// WithResolvedBackends sets the ResolvedBackends field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolvedBackends field is set to the value of the last call.
+func (b *RouteParentStatusApplyConfiguration) WithResolvedBackends(value int32) *RouteParentStatusApplyConfiguration {
+	b.ResolvedBackends = &value
+	return b
+}
+
+// WithTotalBackends sets the TotalBackends field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalBackends field is set to the value of the last call.
+func (b *RouteParentStatusApplyConfiguration) WithTotalBackends(value int32) *RouteParentStatusApplyConfiguration {
+	b.TotalBackends = &value
+	return b
+}
@@ -276,6 +276,29 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: weight
       type:
         scalar: numeric
+- name: io.k8s.sigs.gateway-api.apis.v1.BackendWeightOverride
+  map:
+    fields:
+    - name: group
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: namespace
+      type:
+        scalar: string
+    - name: port
+      type:
+        scalar: numeric
+    - name: weight
+      type:
+        scalar: numeric
+      default: 0
 - name: io.k8s.sigs.gateway-api.apis.v1.CookieConfig
   map:
     fields:
@@ -505,6 +528,23 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.GatewayClassStatus
       default: {}
+- name: io.k8s.sigs.gateway-api.apis.v1.GatewayClassRolloutStatus
+  map:
+    fields:
+    - name: phase
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.gateway-api.apis.v1.GatewayClassRolloutStrategy
+  map:
+    fields:
+    - name: canaryWeight
+      type:
+        scalar: numeric
+    - name: type
+      type:
+        scalar: string
+      default: ""
 - name: io.k8s.sigs.gateway-api.apis.v1.GatewayClassSpec
   map:
     fields:
@@ -518,6 +558,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: parametersRef
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.ParametersReference
+    - name: rolloutStrategy
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.GatewayClassRolloutStrategy
 - name: io.k8s.sigs.gateway-api.apis.v1.GatewayClassStatus
   map:
     fields:
@@ -529,6 +572,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: controllerVersion
+      type:
+        scalar: string
+    - name: rolloutStatus
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.GatewayClassRolloutStatus
     - name: supportedFeatures
       type:
         list:
@@ -551,6 +600,23 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: parametersRef
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.LocalParametersReference
+    - name: resourceAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+- name: io.k8s.sigs.gateway-api.apis.v1.GatewayNetworkStatus
+  map:
+    fields:
+    - name: cloudResourceID
+      type:
+        scalar: string
+    - name: region
+      type:
+        scalar: string
+    - name: zone
+      type:
+        scalar: string
 - name: io.k8s.sigs.gateway-api.apis.v1.GatewaySpec
   map:
     fields:
@@ -600,6 +666,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - name
+    - name: networkStatus
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.GatewayNetworkStatus
 - name: io.k8s.sigs.gateway-api.apis.v1.GatewayStatusAddress
   map:
     fields:
@@ -658,6 +727,31 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: weight
       type:
         scalar: numeric
+- name: io.k8s.sigs.gateway-api.apis.v1.HTTPCircuitBreakerFilter
+  map:
+    fields:
+    - name: cooldownSeconds
+      type:
+        scalar: numeric
+      default: 0
+    - name: maxFailures
+      type:
+        scalar: numeric
+      default: 0
+    - name: windowSeconds
+      type:
+        scalar: numeric
+      default: 0
+- name: io.k8s.sigs.gateway-api.apis.v1.HTTPGRPCTranscodingFilter
+  map:
+    fields:
+    - name: ignoreUnknownQueryParameters
+      type:
+        scalar: boolean
+    - name: protoDescriptorRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.LocalObjectReference
+      default: {}
 - name: io.k8s.sigs.gateway-api.apis.v1.HTTPHeader
   map:
     fields:
@@ -708,9 +802,38 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: io.k8s.sigs.gateway-api.apis.v1.HTTPJWTAuthFilter
+  map:
+    fields:
+    - name: audiences
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: forwardToken
+      type:
+        scalar: boolean
+    - name: headerName
+      type:
+        scalar: string
+    - name: issuer
+      type:
+        scalar: string
+      default: ""
+    - name: jwksUri
+      type:
+        scalar: string
+      default: ""
 - name: io.k8s.sigs.gateway-api.apis.v1.HTTPPathMatch
   map:
     fields:
+    - name: caseInsensitive
+      type:
+        scalar: boolean
+    - name: normalizeTrailingSlash
+      type:
+        scalar: boolean
     - name: type
       type:
         scalar: string
@@ -744,6 +867,13 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: io.k8s.sigs.gateway-api.apis.v1.HTTPRequestBodyLimitFilter
+  map:
+    fields:
+    - name: maxBytes
+      type:
+        scalar: numeric
+      default: 0
 - name: io.k8s.sigs.gateway-api.apis.v1.HTTPRequestMirrorFilter
   map:
     fields:
@@ -751,6 +881,9 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.BackendObjectReference
       default: {}
+    - name: percent
+      type:
+        scalar: numeric
 - name: io.k8s.sigs.gateway-api.apis.v1.HTTPRequestRedirectFilter
   map:
     fields:
@@ -769,6 +902,51 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: statusCode
       type:
         scalar: numeric
+- name: io.k8s.sigs.gateway-api.apis.v1.HTTPResponseCacheFilter
+  map:
+    fields:
+    - name: maxResponseBodyBytes
+      type:
+        scalar: numeric
+    - name: methods
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: privateHeaders
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: ttl
+      type:
+        scalar: string
+    - name: varyHeaders
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: io.k8s.sigs.gateway-api.apis.v1.HTTPResponseCompressionFilter
+  map:
+    fields:
+    - name: encodings
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: excludeContentTypes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: minSizeBytes
+      type:
+        scalar: numeric
 - name: io.k8s.sigs.gateway-api.apis.v1.HTTPRoute
   map:
     fields:
@@ -790,12 +968,36 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPRouteStatus
       default: {}
+- name: io.k8s.sigs.gateway-api.apis.v1.HTTPRouteFallbackPolicy
+  map:
+    fields:
+    - name: maxRetries
+      type:
+        scalar: numeric
+    - name: statusCodes
+      type:
+        list:
+          elementType:
+            scalar: numeric
+          elementRelationship: atomic
 - name: io.k8s.sigs.gateway-api.apis.v1.HTTPRouteFilter
   map:
     fields:
+    - name: circuitBreaker
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPCircuitBreakerFilter
     - name: extensionRef
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.LocalObjectReference
+    - name: grpcTranscoding
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPGRPCTranscodingFilter
+    - name: jwtAuth
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPJWTAuthFilter
+    - name: requestBodyLimit
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPRequestBodyLimitFilter
     - name: requestHeaderModifier
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderFilter
@@ -805,6 +1007,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: requestRedirect
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPRequestRedirectFilter
+    - name: responseCache
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPResponseCacheFilter
+    - name: responseCompression
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPResponseCompressionFilter
     - name: responseHeaderModifier
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderFilter
@@ -840,15 +1048,30 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - name
+    - name: upgrade
+      type:
+        scalar: string
 - name: io.k8s.sigs.gateway-api.apis.v1.HTTPRouteRule
   map:
     fields:
+    - name: autoWeightAdjustment
+      type:
+        scalar: boolean
+    - name: backendOverrides
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.gateway-api.apis.v1.BackendWeightOverride
+          elementRelationship: atomic
     - name: backendRefs
       type:
         list:
           elementType:
             namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPBackendRef
           elementRelationship: atomic
+    - name: fallbackPolicy
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPRouteFallbackPolicy
     - name: filters
       type:
         list:
@@ -861,6 +1084,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPRouteMatch
           elementRelationship: atomic
+    - name: name
+      type:
+        scalar: string
     - name: sessionPersistence
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.SessionPersistence
@@ -882,6 +1108,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.sigs.gateway-api.apis.v1.ParentReference
           elementRelationship: atomic
+    - name: priority
+      type:
+        scalar: numeric
     - name: rules
       type:
         list:
@@ -924,6 +1153,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: hostname
       type:
         scalar: string
+    - name: maxConnections
+      type:
+        scalar: numeric
     - name: name
       type:
         scalar: string
@@ -1090,6 +1322,12 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.ParentReference
       default: {}
+    - name: resolvedBackends
+      type:
+        scalar: numeric
+    - name: totalBackends
+      type:
+        scalar: numeric
 - name: io.k8s.sigs.gateway-api.apis.v1.SecretObjectReference
   map:
     fields:
@@ -41,6 +41,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apisv1.BackendObjectReferenceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BackendRef"):
 		return &apisv1.BackendRefApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackendWeightOverride"):
+		return &apisv1.BackendWeightOverrideApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("CommonRouteSpec"):
 		return &apisv1.CommonRouteSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("CookieConfig"):
@@ -53,12 +55,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apisv1.GatewayAddressApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GatewayClass"):
 		return &apisv1.GatewayClassApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GatewayClassRolloutStatus"):
+		return &apisv1.GatewayClassRolloutStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GatewayClassRolloutStrategy"):
+		return &apisv1.GatewayClassRolloutStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GatewayClassSpec"):
 		return &apisv1.GatewayClassSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GatewayClassStatus"):
 		return &apisv1.GatewayClassStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GatewayInfrastructure"):
 		return &apisv1.GatewayInfrastructureApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GatewayNetworkStatus"):
+		return &apisv1.GatewayNetworkStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GatewaySpec"):
 		return &apisv1.GatewaySpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GatewayStatus"):
@@ -87,24 +95,38 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apisv1.GRPCRouteStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPBackendRef"):
 		return &apisv1.HTTPBackendRefApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPCircuitBreakerFilter"):
+		return &apisv1.HTTPCircuitBreakerFilterApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPGRPCTranscodingFilter"):
+		return &apisv1.HTTPGRPCTranscodingFilterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPHeader"):
 		return &apisv1.HTTPHeaderApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPHeaderFilter"):
 		return &apisv1.HTTPHeaderFilterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPHeaderMatch"):
 		return &apisv1.HTTPHeaderMatchApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPJWTAuthFilter"):
+		return &apisv1.HTTPJWTAuthFilterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPPathMatch"):
 		return &apisv1.HTTPPathMatchApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPPathModifier"):
 		return &apisv1.HTTPPathModifierApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPQueryParamMatch"):
 		return &apisv1.HTTPQueryParamMatchApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPRequestBodyLimitFilter"):
+		return &apisv1.HTTPRequestBodyLimitFilterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPRequestMirrorFilter"):
 		return &apisv1.HTTPRequestMirrorFilterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPRequestRedirectFilter"):
 		return &apisv1.HTTPRequestRedirectFilterApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPResponseCacheFilter"):
+		return &apisv1.HTTPResponseCacheFilterApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPResponseCompressionFilter"):
+		return &apisv1.HTTPResponseCompressionFilterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPRoute"):
 		return &apisv1.HTTPRouteApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPRouteFallbackPolicy"):
+		return &apisv1.HTTPRouteFallbackPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPRouteFilter"):
 		return &apisv1.HTTPRouteFilterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPRouteMatch"):
@@ -181,6 +181,10 @@ type GatewaySpec struct {
 	// Implementations MAY merge separate Gateways onto a single set of
 	// Addresses if all Listeners across all Gateways are compatible.
 	//
+	// <gateway:experimental:description>
+	// Listeners with the HTTPS or TLS protocol must specify tls.
+	// </gateway:experimental:description>
+	//
 	// Support: Core
 	//
 	// +listType=map
@@ -192,6 +196,7 @@ type GatewaySpec struct {
 	// +kubebuilder:validation:XValidation:message="hostname must not be specified for protocols ['TCP', 'UDP']",rule="self.all(l, l.protocol in ['TCP', 'UDP']  ? (!has(l.hostname) || l.hostname == '') : true)"
 	// +kubebuilder:validation:XValidation:message="Listener name must be unique within the Gateway",rule="self.all(l1, self.exists_one(l2, l1.name == l2.name))"
 	// +kubebuilder:validation:XValidation:message="Combination of port, protocol and hostname must be unique for each listener",rule="self.all(l1, self.exists_one(l2, l1.port == l2.port && l1.protocol == l2.protocol && (has(l1.hostname) && has(l2.hostname) ? l1.hostname == l2.hostname : !has(l1.hostname) && !has(l2.hostname))))"
+	// <gateway:experimental:validation:XValidation:message="tls must be specified for protocols ['HTTPS', 'TLS']",rule="self.all(l, l.protocol in ['HTTPS', 'TLS'] ? has(l.tls) : true)">
 	Listeners []Listener `json:"listeners"`
 
 	// Addresses requested for this Gateway. This is optional and behavior can
@@ -323,6 +328,23 @@ type Listener struct {
 	// +kubebuilder:default={namespaces:{from: Same}}
 	// +optional
 	AllowedRoutes *AllowedRoutes `json:"allowedRoutes,omitempty"`
+
+	// MaxConnections is the maximum number of concurrent client connections
+	// accepted by this Listener. When the limit is reached, implementations
+	// MUST respond to new requests with an HTTP 503 (Service Unavailable)
+	// status code for HTTP and HTTPS listeners, and MUST close new
+	// connections for TCP and TLS listeners. While the limit is reached,
+	// implementations SHOULD set the "ConnectionsThrottled" condition to true
+	// on the Listener status. When unspecified, the number of connections is
+	// only limited by the implementation.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2147483648
+	MaxConnections *int64 `json:"maxConnections,omitempty"`
 }
 
 // ProtocolType defines the application protocol accepted by a Listener.
@@ -417,12 +439,25 @@ type GatewayTLSConfig struct {
 	// CertificateRefs can reference to standard Kubernetes resources, i.e.
 	// Secret, or implementation-specific custom resources.
 	//
+	// <gateway:experimental:description>
+	// CertificateRefs can also reference a core Kubernetes ConfigMap, for
+	// certificates produced by tooling that can not write Secrets. The
+	// ConfigMap MUST contain the `tls.crt` and `tls.key` keys, otherwise the
+	// "ResolvedRefs" condition MUST be set to False for this listener with the
+	// "InvalidCertificateRef" reason.
+	// </gateway:experimental:description>
+	//
 	// Support: Core - A single reference to a Kubernetes Secret of type kubernetes.io/tls
 	//
+	// <gateway:experimental:description>
+	// Support: Extended - A single reference to a Kubernetes ConfigMap
+	// </gateway:experimental:description>
+	//
 	// Support: Implementation-specific (More than one reference or other resource types)
 	//
 	// +optional
 	// +kubebuilder:validation:MaxItems=64
+	// <gateway:experimental:validation:XValidation:message="ConfigMap certificateRefs must use the core API group",rule="self.all(ref, !has(ref.kind) || ref.kind != 'ConfigMap' || !has(ref.group) || ref.group == '')">
 	CertificateRefs []SecretObjectReference `json:"certificateRefs,omitempty"`
 
 	// FrontendValidation holds configuration information for validating the frontend (client).
@@ -506,16 +541,35 @@ type AllowedRoutes struct {
 	// Namespaces indicates namespaces from which Routes may be attached to this
 	// Listener. This is restricted to the namespace of this Gateway by default.
 	//
+	// <gateway:experimental:description>
+	// When From is set to "Selector", the selector must not be empty. An empty
+	// selector matches all namespaces, which should instead be expressed with
+	// From set to "All".
+	// </gateway:experimental:description>
+	//
 	// Support: Core
 	//
 	// +optional
 	// +kubebuilder:default={from: Same}
+	// <gateway:experimental:validation:XValidation:message="selector must not be empty when from is Selector, use from All to select all namespaces",rule="!has(self.from) || self.from != 'Selector' || (has(self.selector) && ((has(self.selector.matchLabels) && size(self.selector.matchLabels) > 0) || (has(self.selector.matchExpressions) && size(self.selector.matchExpressions) > 0)))">
 	Namespaces *RouteNamespaces `json:"namespaces,omitempty"`
 
 	// Kinds specifies the groups and kinds of Routes that are allowed to bind
 	// to this Gateway Listener. When unspecified or empty, the kinds of Routes
 	// selected are determined using the Listener protocol.
 	//
+	// <gateway:experimental:description>
+	// The kinds selected for each protocol when Kinds is unspecified or empty
+	// are:
+	//
+	// * HTTP and HTTPS: HTTPRoute and GRPCRoute
+	// * TLS: TLSRoute (Passthrough mode) and TCPRoute (Terminate mode)
+	// * TCP: TCPRoute
+	// * UDP: UDPRoute
+	//
+	// Implementations only select the kinds from this list that they support.
+	// </gateway:experimental:description>
+	//
 	// A RouteGroupKind MUST correspond to kinds of Routes that are compatible
 	// with the application protocol specified in the Listener's Protocol field.
 	// If an implementation does not support or recognize this resource type, it
@@ -668,6 +722,41 @@ type GatewayStatus struct {
 	// +listMapKey=name
 	// +kubebuilder:validation:MaxItems=64
 	Listeners []ListenerStatus `json:"listeners,omitempty"`
+
+	// NetworkStatus provides details about the network infrastructure, such
+	// as a cloud load balancer, that was provisioned for this Gateway. The
+	// addresses of that infrastructure are reported in Addresses.
+	//
+	// Support: Implementation-specific
+	//
+	// +optional
+	// <gateway:experimental>
+	NetworkStatus *GatewayNetworkStatus `json:"networkStatus,omitempty"`
+}
+
+// GatewayNetworkStatus describes the network infrastructure provisioned for a
+// Gateway. All fields are implementation-specific and opaque to Gateway API.
+type GatewayNetworkStatus struct {
+	// CloudResourceID is the identifier of the provisioned cloud resource,
+	// for example the ARN or resource path of a load balancer.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	CloudResourceID string `json:"cloudResourceID,omitempty"`
+
+	// Region is the cloud region in which the network infrastructure was
+	// provisioned.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Region string `json:"region,omitempty"`
+
+	// Zone is the cloud zone in which the network infrastructure was
+	// provisioned, if it is zonal.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Zone string `json:"zone,omitempty"`
 }
 
 // GatewayInfrastructure defines infrastructure level attributes about a Gateway instance.
@@ -698,6 +787,26 @@ type GatewayInfrastructure struct {
 	// +kubebuilder:validation:MaxProperties=8
 	Annotations map[AnnotationKey]AnnotationValue `json:"annotations,omitempty"`
 
+	// ResourceAnnotations are annotations that SHOULD be applied to
+	// infrastructure provisioned outside of Kubernetes in response to this
+	// Gateway, such as cloud load balancers. Unlike Annotations, these are not
+	// intended to be copied onto generated Kubernetes objects, which prevents
+	// cloud controllers from confusing them with unrelated annotations.
+	//
+	// Keys must be valid Kubernetes annotation keys: an optional DNS subdomain
+	// prefix followed by a "/" and a name.
+	//
+	// When a key is present in both Annotations and ResourceAnnotations, the
+	// value from ResourceAnnotations takes precedence for provisioned
+	// infrastructure.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=63
+	// +kubebuilder:validation:XValidation:message="resourceAnnotations keys must be valid annotation keys",rule="self.all(key, key.matches(r\"\"\"^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$\"\"\"))"
+	ResourceAnnotations map[AnnotationKey]AnnotationValue `json:"resourceAnnotations,omitempty"`
+
 	// ParametersRef is a reference to a resource that contains the configuration
 	// parameters corresponding to the Gateway. This is optional if the
 	// controller does not require any additional configuration.
@@ -1145,6 +1254,28 @@ const (
 	ListenerReasonPending ListenerConditionReason = "Pending"
 )
 
+const (
+	// This condition indicates that the Listener has reached the number of
+	// concurrent connections configured in MaxConnections, and new
+	// connections or requests are being rejected.
+	//
+	// This condition MUST only be set when it is "True", and MUST be removed
+	// once the number of connections drops below the limit.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "MaxConnectionsReached"
+	//
+	// Controllers may raise this condition with other reasons,
+	// but should prefer to use the reasons listed above to improve
+	// interoperability.
+	ListenerConditionConnectionsThrottled ListenerConditionType = "ConnectionsThrottled"
+
+	// This reason is used with the "ConnectionsThrottled" condition when the
+	// number of concurrent connections has reached MaxConnections.
+	ListenerReasonMaxConnectionsReached ListenerConditionReason = "MaxConnectionsReached"
+)
+
 const (
 	// "Ready" is a condition type reserved for future use. It should not be used by implementations.
 	// Note: This condition is not really "deprecated", but rather "reserved"; however, deprecated triggers Go linters
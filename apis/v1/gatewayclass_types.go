@@ -112,6 +112,56 @@ type GatewayClassSpec struct {
 	// +kubebuilder:validation:MaxLength=64
 	// +optional
 	Description *string `json:"description,omitempty"`
+
+	// RolloutStrategy defines how the controller rolls out changes to the
+	// data plane of Gateways of this class, such as a controller version
+	// upgrade. When unspecified, the rollout behavior is
+	// implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	RolloutStrategy *GatewayClassRolloutStrategy `json:"rolloutStrategy,omitempty"`
+}
+
+// GatewayClassRolloutStrategyType identifies a rollout strategy.
+//
+// +kubebuilder:validation:Enum=Recreate;BlueGreen
+type GatewayClassRolloutStrategyType string
+
+const (
+	// GatewayClassRolloutStrategyRecreate replaces the data plane of each
+	// Gateway in place.
+	GatewayClassRolloutStrategyRecreate GatewayClassRolloutStrategyType = "Recreate"
+
+	// GatewayClassRolloutStrategyBlueGreen provisions a parallel data plane for
+	// each Gateway and gradually shifts traffic to it before removing the
+	// original data plane.
+	GatewayClassRolloutStrategyBlueGreen GatewayClassRolloutStrategyType = "BlueGreen"
+)
+
+// GatewayClassRolloutStrategy defines how changes to the data plane of
+// Gateways of a GatewayClass are rolled out.
+//
+// +kubebuilder:validation:XValidation:message="canaryWeight may only be set when type is BlueGreen",rule="self.type == 'BlueGreen' || !has(self.canaryWeight)"
+type GatewayClassRolloutStrategy struct {
+	// Type is the type of rollout strategy.
+	//
+	// Support: Extended
+	Type GatewayClassRolloutStrategyType `json:"type"`
+
+	// CanaryWeight is the percentage of traffic shifted to the new data plane
+	// while a BlueGreen rollout is in the "CanaryActive" phase. When
+	// unspecified, the amount of traffic shifted and how it progresses is
+	// implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	CanaryWeight *int32 `json:"canaryWeight,omitempty"`
 }
 
 // ParametersReference identifies an API object containing controller-specific
@@ -267,6 +317,56 @@ type GatewayClassStatus struct {
 	// <gateway:experimental>
 	// +kubebuilder:validation:MaxItems=64
 	SupportedFeatures []SupportedFeature `json:"supportedFeatures,omitempty"`
+
+	// ControllerVersion is the version of the controller that last reconciled
+	// this GatewayClass, for example "v1.2.0". Controllers SHOULD set this
+	// field on every reconcile. A controller that finds a version it can no
+	// longer support SHOULD set the "Accepted" condition to false with the
+	// "UnsupportedVersion" reason. The format of the version is
+	// implementation-specific, but semantic versions are recommended.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	// +kubebuilder:validation:MaxLength=63
+	ControllerVersion string `json:"controllerVersion,omitempty"`
+
+	// RolloutStatus describes the progress of the most recent rollout
+	// performed according to the RolloutStrategy of this GatewayClass.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	RolloutStatus *GatewayClassRolloutStatus `json:"rolloutStatus,omitempty"`
+}
+
+// GatewayClassRolloutPhase is the phase of a GatewayClass rollout.
+//
+// +kubebuilder:validation:Enum=Stable;CanaryActive;CutoverComplete
+type GatewayClassRolloutPhase string
+
+const (
+	// GatewayClassRolloutPhaseStable indicates that no rollout is in
+	// progress.
+	GatewayClassRolloutPhaseStable GatewayClassRolloutPhase = "Stable"
+
+	// GatewayClassRolloutPhaseCanaryActive indicates that a BlueGreen rollout
+	// is in progress and a portion of traffic is being sent to the new data
+	// plane.
+	GatewayClassRolloutPhaseCanaryActive GatewayClassRolloutPhase = "CanaryActive"
+
+	// GatewayClassRolloutPhaseCutoverComplete indicates that all traffic has
+	// been shifted to the new data plane and the original data plane is being
+	// or has been removed.
+	GatewayClassRolloutPhaseCutoverComplete GatewayClassRolloutPhase = "CutoverComplete"
+)
+
+// GatewayClassRolloutStatus describes the progress of a GatewayClass rollout.
+type GatewayClassRolloutStatus struct {
+	// Phase is the current phase of the rollout.
+	Phase GatewayClassRolloutPhase `json:"phase"`
 }
 
 // +kubebuilder:object:root=true
@@ -114,11 +114,32 @@ type HTTPRouteSpec struct {
 	// +kubebuilder:validation:MaxItems=16
 	Hostnames []Hostname `json:"hostnames,omitempty"`
 
+	// Priority is an explicit precedence for this HTTPRoute relative to
+	// other HTTPRoutes with conflicting matches. A lower value indicates a
+	// higher priority, so a Route with priority 0 takes precedence over a
+	// Route with priority 10.
+	//
+	// When Priority is set, it takes precedence over the tie-breaking rules
+	// defined for HTTPRouteMatches, and Routes that set a Priority take
+	// precedence over Routes that do not. When Priority is unset, or multiple
+	// Routes specify the same Priority, the existing tie-breaking rules
+	// (most specific match, then oldest Route, then alphabetical order of
+	// "{namespace}/{name}") apply.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	Priority *int32 `json:"priority,omitempty"`
+
 	// Rules are a list of HTTP matchers, filters and actions.
 	//
 	// +optional
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:default={{matches: {{path: {type: "PathPrefix", value: "/"}}}}}
+	// <gateway:experimental:validation:XValidation:message="Rule name must be unique within the route",rule="self.all(l1, !has(l1.name) || self.exists_one(l2, has(l2.name) && l1.name == l2.name))">
 	Rules []HTTPRouteRule `json:"rules,omitempty"`
 }
 
@@ -132,6 +153,17 @@ type HTTPRouteSpec struct {
 // +kubebuilder:validation:XValidation:message="Within backendRefs, when using RequestRedirect filter with path.replacePrefixMatch, exactly one PathPrefix match must be specified",rule="(has(self.backendRefs) && self.backendRefs.exists_one(b, (has(b.filters) && b.filters.exists_one(f, has(f.requestRedirect) && has(f.requestRedirect.path) && f.requestRedirect.path.type == 'ReplacePrefixMatch' && has(f.requestRedirect.path.replacePrefixMatch))) )) ? ((size(self.matches) != 1 || !has(self.matches[0].path) || self.matches[0].path.type != 'PathPrefix') ? false : true) : true"
 // +kubebuilder:validation:XValidation:message="Within backendRefs, When using URLRewrite filter with path.replacePrefixMatch, exactly one PathPrefix match must be specified",rule="(has(self.backendRefs) && self.backendRefs.exists_one(b, (has(b.filters) && b.filters.exists_one(f, has(f.urlRewrite) && has(f.urlRewrite.path) && f.urlRewrite.path.type == 'ReplacePrefixMatch' && has(f.urlRewrite.path.replacePrefixMatch))) )) ? ((size(self.matches) != 1 || !has(self.matches[0].path) || self.matches[0].path.type != 'PathPrefix') ? false : true) : true"
 type HTTPRouteRule struct {
+	// Name is the name of the route rule. This name MUST be unique within a Route
+	// if it is set. Unlike the index of a rule, the name does not change when
+	// rules are reordered, so implementations can use it to identify a rule in
+	// status, logs and metrics.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	Name *SectionName `json:"name,omitempty"`
+
 	// Matches define conditions used for matching the rule against incoming
 	// HTTP requests. Each match is independent, i.e. this rule will be matched
 	// if **any** one of the matches is satisfied.
@@ -238,6 +270,24 @@ type HTTPRouteRule struct {
 	// +kubebuilder:validation:XValidation:message="ResponseHeaderModifier filter cannot be repeated",rule="self.filter(f, f.type == 'ResponseHeaderModifier').size() <= 1"
 	// +kubebuilder:validation:XValidation:message="RequestRedirect filter cannot be repeated",rule="self.filter(f, f.type == 'RequestRedirect').size() <= 1"
 	// +kubebuilder:validation:XValidation:message="URLRewrite filter cannot be repeated",rule="self.filter(f, f.type == 'URLRewrite').size() <= 1"
+	// <gateway:experimental:validation:XValidation:message="RequestBodyLimit filter cannot be repeated",rule="self.filter(f, f.type == 'RequestBodyLimit').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.requestBodyLimit must be nil if the filter.type is not RequestBodyLimit",rule="self.all(f, !(has(f.requestBodyLimit) && f.type != 'RequestBodyLimit'))">
+	// <gateway:experimental:validation:XValidation:message="filter.requestBodyLimit must be specified for RequestBodyLimit filter.type",rule="self.all(f, !(!has(f.requestBodyLimit) && f.type == 'RequestBodyLimit'))">
+	// <gateway:experimental:validation:XValidation:message="ResponseCompression filter cannot be repeated",rule="self.filter(f, f.type == 'ResponseCompression').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.responseCompression must be nil if the filter.type is not ResponseCompression",rule="self.all(f, !(has(f.responseCompression) && f.type != 'ResponseCompression'))">
+	// <gateway:experimental:validation:XValidation:message="filter.responseCompression must be specified for ResponseCompression filter.type",rule="self.all(f, !(!has(f.responseCompression) && f.type == 'ResponseCompression'))">
+	// <gateway:experimental:validation:XValidation:message="JWTAuth filter cannot be repeated",rule="self.filter(f, f.type == 'JWTAuth').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.jwtAuth must be nil if the filter.type is not JWTAuth",rule="self.all(f, !(has(f.jwtAuth) && f.type != 'JWTAuth'))">
+	// <gateway:experimental:validation:XValidation:message="filter.jwtAuth must be specified for JWTAuth filter.type",rule="self.all(f, !(!has(f.jwtAuth) && f.type == 'JWTAuth'))">
+	// <gateway:experimental:validation:XValidation:message="CircuitBreaker filter cannot be repeated",rule="self.filter(f, f.type == 'CircuitBreaker').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.circuitBreaker must be nil if the filter.type is not CircuitBreaker",rule="self.all(f, !(has(f.circuitBreaker) && f.type != 'CircuitBreaker'))">
+	// <gateway:experimental:validation:XValidation:message="filter.circuitBreaker must be specified for CircuitBreaker filter.type",rule="self.all(f, !(!has(f.circuitBreaker) && f.type == 'CircuitBreaker'))">
+	// <gateway:experimental:validation:XValidation:message="ResponseCache filter cannot be repeated",rule="self.filter(f, f.type == 'ResponseCache').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.responseCache must be nil if the filter.type is not ResponseCache",rule="self.all(f, !(has(f.responseCache) && f.type != 'ResponseCache'))">
+	// <gateway:experimental:validation:XValidation:message="filter.responseCache must be specified for ResponseCache filter.type",rule="self.all(f, !(!has(f.responseCache) && f.type == 'ResponseCache'))">
+	// <gateway:experimental:validation:XValidation:message="GRPCTranscoding filter cannot be repeated",rule="self.filter(f, f.type == 'GRPCTranscoding').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.grpcTranscoding must be nil if the filter.type is not GRPCTranscoding",rule="self.all(f, !(has(f.grpcTranscoding) && f.type != 'GRPCTranscoding'))">
+	// <gateway:experimental:validation:XValidation:message="filter.grpcTranscoding must be specified for GRPCTranscoding filter.type",rule="self.all(f, !(!has(f.grpcTranscoding) && f.type == 'GRPCTranscoding'))">
 	Filters []HTTPRouteFilter `json:"filters,omitempty"`
 
 	// BackendRefs defines the backend(s) where matching requests should be
@@ -263,6 +313,11 @@ type HTTPRouteRule struct {
 	// invalid, 50 percent of traffic must receive a 500. Implementations may
 	// choose how that 50 percent is determined.
 	//
+	// <gateway:experimental:description>
+	// When BackendRefs are specified, at least one of them must have a non-zero
+	// weight.
+	// </gateway:experimental:description>
+	//
 	// Support: Core for Kubernetes Service
 	//
 	// Support: Extended for Kubernetes ServiceImport
@@ -273,6 +328,7 @@ type HTTPRouteRule struct {
 	//
 	// +optional
 	// +kubebuilder:validation:MaxItems=16
+	// <gateway:experimental:validation:XValidation:message="at least one backendRef must have a non-zero weight",rule="self.size() == 0 || self.exists(b, !has(b.weight) || b.weight != 0)">
 	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
 
 	// Timeouts defines the timeouts that can be configured for an HTTP request.
@@ -291,8 +347,129 @@ type HTTPRouteRule struct {
 	// +optional
 	// <gateway:experimental>
 	SessionPersistence *SessionPersistence `json:"sessionPersistence,omitempty"`
+
+	// FallbackPolicy defines how requests are retried on other backends when
+	// the selected backend responds with a server error.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	FallbackPolicy *HTTPRouteFallbackPolicy `json:"fallbackPolicy,omitempty"`
+
+	// BackendOverrides temporarily override the weights of BackendRefs in
+	// this rule without modifying the BackendRefs themselves, for example to
+	// shift traffic away from a degraded backend. Each override applies to
+	// every BackendRef in this rule that references the same backend, which
+	// means its group, kind, namespace, name and port all match. An unset
+	// namespace refers to the namespace of the Route on both sides. Overrides
+	// that do not match any BackendRef are ignored.
+	//
+	// Overrides are not subject to the requirement that at least one
+	// BackendRef has a non-zero weight. When the overrides leave every
+	// BackendRef in this rule with a weight of 0, all traffic which matches
+	// this rule MUST receive a 500 status code.
+	//
+	// Implementations that apply these overrides identify themselves through
+	// the ControllerName of the corresponding RouteParentStatus.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="backendOverrides must be unique",rule="self.all(o1, self.exists_one(o2, o1.group == o2.group && o1.kind == o2.kind && o1.name == o2.name && (has(o1.__namespace__) ? (has(o2.__namespace__) && o1.__namespace__ == o2.__namespace__) : !has(o2.__namespace__)) && (has(o1.port) ? (has(o2.port) && o1.port == o2.port) : !has(o2.port))))"
+	BackendOverrides []BackendWeightOverride `json:"backendOverrides,omitempty"`
+
+	// AutoWeightAdjustment instructs the implementation to reduce the
+	// effective weight of BackendRefs it has determined to be unhealthy, for
+	// example through active or passive health checks. How the health of a
+	// backend is determined is implementation-specific.
+	//
+	// When enabled, implementations SHOULD adjust weights as follows:
+	//
+	// * After 2 consecutive failed health checks, the effective weight of the
+	//   backend is reduced by 50%.
+	// * Each further consecutive failure reduces the effective weight by
+	//   another 50%, down to a minimum effective weight of 1.
+	// * A single passing health check restores the configured weight.
+	//
+	// While any effective weight differs from its configured weight,
+	// implementations SHOULD set the "AutoWeightAdjustmentActive" condition
+	// to true on the corresponding RouteParentStatus.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	AutoWeightAdjustment *bool `json:"autoWeightAdjustment,omitempty"`
+}
+
+// BackendWeightOverride overrides the weight of the BackendRefs referencing
+// the given backend within an HTTPRouteRule.
+type BackendWeightOverride struct {
+	// BackendObjectReference references the backend whose BackendRefs have
+	// their weight overridden.
+	BackendObjectReference `json:",inline"`
+
+	// Weight replaces the weight of the matching BackendRefs. The same
+	// semantics as the BackendRef weight apply, so a weight of 0 stops
+	// traffic from being forwarded to the matching BackendRefs.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000000
+	Weight int32 `json:"weight"`
 }
 
+// HTTPRouteFallbackPolicy configures retrying a request on a different
+// backend when the backend that was originally selected responds with one of
+// the configured status codes.
+//
+// The initial backend is selected using the usual weight-based selection.
+// When that backend responds with one of the listed StatusCodes, the request
+// MUST be retried on the next BackendRef in the order in which BackendRefs
+// are listed, wrapping around to the start of the list, regardless of their
+// weights. This means that a BackendRef with a weight of 0 can be used as a
+// dedicated fallback that never receives traffic directly.
+//
+// A backend that cannot be reached, for example because the connection is
+// refused, MUST be treated as if it responded with a 503 (Service
+// Unavailable) status code.
+//
+// A backend MUST NOT receive more than one attempt for the same request. If
+// all attempts are exhausted, the response from the last attempt MUST be
+// returned to the client.
+type HTTPRouteFallbackPolicy struct {
+	// StatusCodes is the list of HTTP response status codes from a backend
+	// that trigger a retry on the next BackendRef.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	StatusCodes []HTTPRouteFallbackStatusCode `json:"statusCodes"`
+
+	// MaxRetries is the maximum number of times a request is retried on a
+	// different BackendRef. The number of retries is also limited by the
+	// number of BackendRefs in the rule, since each backend receives at most
+	// one attempt.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=15
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+// HTTPRouteFallbackStatusCode is a 5xx HTTP response status code that
+// triggers a fallback to another backend.
+//
+// +kubebuilder:validation:Minimum=500
+// +kubebuilder:validation:Maximum=599
+type HTTPRouteFallbackStatusCode int
+
 // HTTPRouteTimeouts defines timeouts that can be configured for an HTTPRoute.
 // Timeout values are represented with Gateway API Duration formatting.
 //
@@ -426,6 +603,34 @@ type HTTPPathMatch struct {
 	// +kubebuilder:default="/"
 	// +kubebuilder:validation:MaxLength=1024
 	Value *string `json:"value,omitempty"`
+
+	// NormalizeTrailingSlash specifies whether a trailing slash is ignored when
+	// matching the request path. When set to true, an "Exact" match for `/foo`
+	// also matches a request for `/foo/`, and an "Exact" match for `/foo/` also
+	// matches a request for `/foo`. When unset or false, trailing slashes are
+	// handled as they are today.
+	//
+	// This field is only honored for the "Exact" path match type; "PathPrefix"
+	// matches already ignore a trailing slash at an element boundary.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	NormalizeTrailingSlash *bool `json:"normalizeTrailingSlash,omitempty"`
+
+	// CaseInsensitive specifies whether the request path is matched against
+	// Value case-insensitively. When set to true, an "Exact" match for
+	// `/foo/bar` also matches a request for `/Foo/Bar`. When unset or false,
+	// paths are matched case-sensitively as required by RFC 9110.
+	//
+	// This field is honored for the "Exact" and "PathPrefix" path match types.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	CaseInsensitive *bool `json:"caseInsensitive,omitempty"`
 }
 
 // HeaderMatchType specifies the semantics of how HTTP header values should be
@@ -655,8 +860,53 @@ type HTTPRouteMatch struct {
 	//
 	// +optional
 	Method *HTTPMethod `json:"method,omitempty"`
+
+	// Upgrade specifies a protocol upgrade matcher. When specified, this route
+	// will be matched only if the request asks to upgrade the connection to
+	// the specified protocol, as indicated by the "Connection: Upgrade" and
+	// "Upgrade" request headers. Requests that do not ask for an upgrade, or
+	// ask for a different protocol, do not match.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	Upgrade *HTTPUpgradeType `json:"upgrade,omitempty"`
 }
 
+// HTTPUpgradeType describes how to select a HTTP route by matching the
+// protocol a request asks to upgrade the connection to, as defined by
+// [RFC 9110](https://datatracker.ietf.org/doc/html/rfc9110#section-7.8).
+//
+//   - "Websocket" matches an upgrade to the "websocket" protocol
+//     ([RFC 6455](https://datatracker.ietf.org/doc/html/rfc6455)).
+//   - "HTTP2" matches an upgrade to the "h2c" protocol, HTTP/2 over cleartext
+//     TCP ([RFC 7540](https://datatracker.ietf.org/doc/html/rfc7540#section-3.2)).
+//     As this upgrade is only possible on cleartext connections, a route using
+//     this matcher attached to a listener with a protocol other than "HTTP"
+//     MUST have the Accepted Condition for that parent set to `status: False`,
+//     with a Reason of `UnsupportedValue`.
+//   - "SPDY" matches an upgrade to any version of the SPDY protocol, such as
+//     "SPDY/3.1".
+//
+// Upgrade protocol tokens are matched case-insensitively.
+//
+// Note that values may be added to this enum, implementations
+// must ensure that unknown values will not cause a crash.
+//
+// Unknown values here must result in the implementation setting the
+// Accepted Condition for the Route to `status: False`, with a
+// Reason of `UnsupportedValue`.
+//
+// +kubebuilder:validation:Enum=Websocket;HTTP2;SPDY
+type HTTPUpgradeType string
+
+const (
+	HTTPUpgradeWebsocket HTTPUpgradeType = "Websocket"
+	HTTPUpgradeHTTP2     HTTPUpgradeType = "HTTP2"
+	HTTPUpgradeSPDY      HTTPUpgradeType = "SPDY"
+)
+
 // HTTPRouteFilter defines processing steps that must be completed during the
 // request or response lifecycle. HTTPRouteFilters are meant as an extension
 // point to express processing that may be done in Gateway implementations. Some
@@ -712,6 +962,7 @@ type HTTPRouteFilter struct {
 	//
 	// +unionDiscriminator
 	// +kubebuilder:validation:Enum=RequestHeaderModifier;ResponseHeaderModifier;RequestMirror;RequestRedirect;URLRewrite;ExtensionRef
+	// <gateway:experimental:validation:Enum=RequestHeaderModifier;ResponseHeaderModifier;RequestMirror;RequestRedirect;URLRewrite;ExtensionRef;RequestBodyLimit;ResponseCompression;JWTAuth;CircuitBreaker;ResponseCache;GRPCTranscoding>
 	Type HTTPRouteFilterType `json:"type"`
 
 	// RequestHeaderModifier defines a schema for a filter that modifies request
@@ -769,6 +1020,60 @@ type HTTPRouteFilter struct {
 	//
 	// +optional
 	ExtensionRef *LocalObjectReference `json:"extensionRef,omitempty"`
+
+	// RequestBodyLimit defines a schema for a filter that limits the size of
+	// request bodies.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	RequestBodyLimit *HTTPRequestBodyLimitFilter `json:"requestBodyLimit,omitempty"`
+
+	// ResponseCompression defines a schema for a filter that compresses
+	// response bodies.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	ResponseCompression *HTTPResponseCompressionFilter `json:"responseCompression,omitempty"`
+
+	// JWTAuth defines a schema for a filter that authenticates requests using
+	// JSON Web Tokens.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	JWTAuth *HTTPJWTAuthFilter `json:"jwtAuth,omitempty"`
+
+	// CircuitBreaker defines a schema for a filter that stops sending
+	// traffic to failing backends.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	CircuitBreaker *HTTPCircuitBreakerFilter `json:"circuitBreaker,omitempty"`
+
+	// ResponseCache defines a schema for a filter that caches responses at
+	// the Gateway.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	ResponseCache *HTTPResponseCacheFilter `json:"responseCache,omitempty"`
+
+	// GRPCTranscoding defines a schema for a filter that transcodes HTTP/JSON
+	// requests to gRPC.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	GRPCTranscoding *HTTPGRPCTranscodingFilter `json:"grpcTranscoding,omitempty"`
 }
 
 // HTTPRouteFilterType identifies a type of HTTPRoute filter.
@@ -827,6 +1132,54 @@ const (
 	//
 	// Support in HTTPBackendRef: Implementation-specific
 	HTTPRouteFilterExtensionRef HTTPRouteFilterType = "ExtensionRef"
+
+	// HTTPRouteFilterRequestBodyLimit can be used to reject HTTP requests
+	// with a body larger than a configured size.
+	//
+	// Support in HTTPRouteRule: Extended
+	//
+	// Support in HTTPBackendRef: Extended
+	HTTPRouteFilterRequestBodyLimit HTTPRouteFilterType = "RequestBodyLimit"
+
+	// HTTPRouteFilterResponseCompression can be used to compress HTTP
+	// response bodies before they are sent to the client.
+	//
+	// Support in HTTPRouteRule: Extended
+	//
+	// Support in HTTPBackendRef: Extended
+	HTTPRouteFilterResponseCompression HTTPRouteFilterType = "ResponseCompression"
+
+	// HTTPRouteFilterJWTAuth can be used to reject HTTP requests that do not
+	// carry a valid JSON Web Token.
+	//
+	// Support in HTTPRouteRule: Extended
+	//
+	// Support in HTTPBackendRef: Extended
+	HTTPRouteFilterJWTAuth HTTPRouteFilterType = "JWTAuth"
+
+	// HTTPRouteFilterCircuitBreaker can be used to stop sending HTTP
+	// requests to a backend that keeps failing.
+	//
+	// Support in HTTPRouteRule: Extended
+	//
+	// Support in HTTPBackendRef: Extended
+	HTTPRouteFilterCircuitBreaker HTTPRouteFilterType = "CircuitBreaker"
+
+	// HTTPRouteFilterResponseCache can be used to serve cached responses
+	// without forwarding the request to the backend.
+	//
+	// Support in HTTPRouteRule: Extended
+	//
+	// Support in HTTPBackendRef: Extended
+	HTTPRouteFilterResponseCache HTTPRouteFilterType = "ResponseCache"
+
+	// HTTPRouteFilterGRPCTranscoding can be used to expose gRPC backends to
+	// HTTP/JSON clients.
+	//
+	// Support in HTTPRouteRule: Extended
+	//
+	// Support in HTTPBackendRef: Extended
+	HTTPRouteFilterGRPCTranscoding HTTPRouteFilterType = "GRPCTranscoding"
 )
 
 // HTTPHeader represents an HTTP Header name and value as defined by RFC 7230.
@@ -1137,8 +1490,299 @@ type HTTPRequestMirrorFilter struct {
 	//
 	// Support: Implementation-specific for any other resource
 	BackendRef BackendObjectReference `json:"backendRef"`
+
+	// Percent represents the percentage of requests that should be mirrored
+	// to BackendRef. Its minimum value is 0 (indicating 0% of requests) and
+	// its maximum value is 100 (indicating 100% of requests).
+	//
+	// If unset, 100% of requests are mirrored.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// <gateway:experimental>
+	Percent *int32 `json:"percent,omitempty"`
+}
+
+// HTTPRequestBodyLimitFilter defines a filter that rejects requests with a
+// body larger than MaxBytes. Requests that exceed the limit MUST NOT be
+// forwarded to the backend, and the implementation MUST respond with an HTTP
+// 413 (Payload Too Large) status code. Requests without a body, or with a
+// body of at most MaxBytes bytes, are not affected.
+//
+// Both requests with a Content-Length header and requests using chunked
+// transfer encoding are subject to the limit.
+type HTTPRequestBodyLimitFilter struct {
+	// MaxBytes is the maximum size of a request body in bytes.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9007199254740992
+	MaxBytes int64 `json:"maxBytes"`
 }
 
+// HTTPResponseCompressionFilter defines a filter that compresses response
+// bodies using one of the encodings accepted by the client.
+//
+// The encoding is negotiated using the Accept-Encoding header of the request.
+// When the request does not include an Accept-Encoding header, or none of the
+// accepted encodings are configured in Encodings, the response MUST NOT be
+// compressed by this filter. When a response is compressed, the
+// implementation MUST set the Content-Encoding header to the selected
+// encoding. Responses that already have a Content-Encoding header MUST NOT be
+// compressed again.
+type HTTPResponseCompressionFilter struct {
+	// Encodings is the list of encodings that may be used to compress
+	// responses, in order of preference. When unspecified, the
+	// implementation MUST use Gzip.
+	//
+	// Support: Extended for Gzip
+	//
+	// Support: Implementation-specific for Brotli and Deflate
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:XValidation:message="encodings must be unique",rule="self.all(e1, self.exists_one(e2, e1 == e2))"
+	Encodings []CompressionEncoding `json:"encodings,omitempty"`
+
+	// MinSizeBytes is the minimum size of a response body in bytes for it to
+	// be compressed. Smaller responses are sent uncompressed. When
+	// unspecified, the minimum size is implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinSizeBytes *int64 `json:"minSizeBytes,omitempty"`
+
+	// ExcludeContentTypes is a list of media types, such as "image/png",
+	// for which responses MUST NOT be compressed. Media types are compared
+	// case-insensitively against the Content-Type header of the response,
+	// ignoring any parameters.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=256
+	ExcludeContentTypes []string `json:"excludeContentTypes,omitempty"`
+}
+
+// HTTPJWTAuthFilter defines a filter that authenticates requests using JSON
+// Web Tokens (RFC 7519). Requests without a token, or with a token that is
+// malformed, expired, not signed by a key from JWKSURI, not issued by Issuer,
+// or not intended for one of the Audiences, MUST NOT be forwarded to the
+// backend, and the implementation MUST respond with an HTTP 401
+// (Unauthorized) status code.
+type HTTPJWTAuthFilter struct {
+	// Issuer is the expected value of the "iss" claim of the token.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	Issuer string `json:"issuer"`
+
+	// Audiences is the list of accepted values of the "aud" claim of the
+	// token. When unspecified, the "aud" claim is not checked.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=256
+	Audiences []string `json:"audiences,omitempty"`
+
+	// JWKSURI is the URI of the JSON Web Key Set (RFC 7517) containing the
+	// public keys used to verify the signature of the token.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	JWKSURI string `json:"jwksUri"`
+
+	// HeaderName is the name of the request header that carries the token.
+	// When the header is "Authorization", the token MUST use the "Bearer"
+	// scheme (RFC 6750). When unspecified, "Authorization" is used.
+	//
+	// Support: Extended
+	//
+	// +optional
+	HeaderName *HTTPHeaderName `json:"headerName,omitempty"`
+
+	// ForwardToken specifies whether the header carrying the token is
+	// forwarded to the backend. When set to false, the header MUST be removed
+	// from the request before it is forwarded. When unspecified, the header
+	// is forwarded.
+	//
+	// Support: Extended
+	//
+	// +optional
+	ForwardToken *bool `json:"forwardToken,omitempty"`
+}
+
+// HTTPCircuitBreakerFilter defines a filter that stops sending requests to a
+// backend once it has failed MaxFailures times within WindowSeconds. A request
+// fails when the backend cannot be reached or responds with a 5xx status code.
+//
+// When used in an HTTPRouteRule, each of the rule's BackendRefs is tracked
+// separately. When used in an HTTPBackendRef, only that BackendRef is tracked.
+//
+// While the circuit of a backend is open, requests that would have been sent
+// to it MUST be sent to the next BackendRef of the rule with a closed circuit,
+// in the order the BackendRefs are listed, wrapping around to the start of the
+// list, regardless of weight, including weight 0. This means that a BackendRef
+// with a weight of 0 can be used as a dedicated failover target that never
+// receives traffic directly. When no such BackendRef exists,
+// the implementation MUST respond with an HTTP 503 (Service Unavailable)
+// status code. After CooldownSeconds, the circuit is closed again.
+type HTTPCircuitBreakerFilter struct {
+	// MaxFailures is the number of failed requests within WindowSeconds after
+	// which the circuit of a backend is opened.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	MaxFailures int32 `json:"maxFailures"`
+
+	// WindowSeconds is the length of the sliding window, in seconds, over
+	// which failures are counted.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	WindowSeconds int32 `json:"windowSeconds"`
+
+	// CooldownSeconds is the time, in seconds, for which the circuit of a
+	// backend stays open before traffic is sent to it again.
+	//
+	// Support: Extended
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	CooldownSeconds int32 `json:"cooldownSeconds"`
+}
+
+// HTTPResponseCacheFilter defines a filter that caches responses at the
+// Gateway. While a cached response is fresh, matching requests MUST be served
+// from the cache without being forwarded to the backend.
+//
+// Responses are cached per method, host, path, query and the values of the
+// VaryHeaders of the request. Implementations MUST honor the Cache-Control
+// and Vary headers of the response as described in RFC 9111.
+type HTTPResponseCacheFilter struct {
+	// TTL is how long a response is considered fresh when it does not define
+	// its own freshness lifetime, for example through a Cache-Control max-age
+	// directive. When unspecified, the TTL is implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:message="ttl must be greater than 0s",rule="duration(self) > duration('0s')"
+	TTL *Duration `json:"ttl,omitempty"`
+
+	// VaryHeaders is a list of request headers whose values are part of the
+	// cache key, in addition to the headers listed in the Vary header of the
+	// response.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	VaryHeaders []HTTPHeaderName `json:"varyHeaders,omitempty"`
+
+	// Methods is the list of request methods whose responses may be cached.
+	// When unspecified, responses to GET and HEAD requests are cached.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=9
+	Methods []HTTPMethod `json:"methods,omitempty"`
+
+	// PrivateHeaders is a list of response headers whose presence makes a
+	// response non-cacheable, for example "Set-Cookie".
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	PrivateHeaders []HTTPHeaderName `json:"privateHeaders,omitempty"`
+
+	// MaxResponseBodyBytes is the maximum size of a response body in bytes for
+	// the response to be cached. Larger responses are not cached. When
+	// unspecified, the maximum size is implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxResponseBodyBytes *int64 `json:"maxResponseBodyBytes,omitempty"`
+}
+
+// HTTPGRPCTranscodingFilter defines a filter that transcodes HTTP/JSON
+// requests to gRPC requests, and gRPC responses back to HTTP/JSON responses,
+// following the HTTP annotations (google.api.http) of the gRPC service
+// definitions. Requests that do not map to a gRPC method are forwarded to the
+// backend unchanged.
+//
+// Backends of a rule using this filter are expected to speak gRPC, for
+// example by setting the "kubernetes.io/h2c" appProtocol on their Service
+// port.
+type HTTPGRPCTranscodingFilter struct {
+	// ProtoDescriptorRef references the object containing the compiled
+	// protocol buffer descriptor set of the gRPC services to transcode.
+	//
+	// Support: Extended for a ConfigMap with the descriptor set stored under
+	// the "descriptor.pb" key in its binaryData
+	//
+	// Support: Implementation-specific for other resource types
+	ProtoDescriptorRef LocalObjectReference `json:"protoDescriptorRef"`
+
+	// IgnoreUnknownQueryParameters specifies whether query parameters that do
+	// not map to a field of the gRPC request message are ignored. When unset
+	// or false, requests with unknown query parameters MUST be rejected with
+	// an HTTP 400 (Bad Request) status code.
+	//
+	// Support: Extended
+	//
+	// +optional
+	IgnoreUnknownQueryParameters *bool `json:"ignoreUnknownQueryParameters,omitempty"`
+}
+
+// CompressionEncoding is an HTTP content coding that can be used to compress
+// response bodies.
+//
+// Note that values may be added to this enum, implementations
+// must ensure that unknown values will not cause a crash.
+//
+// Unknown values here must result in the implementation setting the
+// Accepted Condition for the Route to `status: False`, with a
+// Reason of `UnsupportedValue`.
+//
+// +kubebuilder:validation:Enum=Gzip;Brotli;Deflate
+type CompressionEncoding string
+
+const (
+	// CompressionEncodingGzip compresses responses using the "gzip" content
+	// coding.
+	CompressionEncodingGzip CompressionEncoding = "Gzip"
+
+	// CompressionEncodingBrotli compresses responses using the "br" content
+	// coding.
+	CompressionEncodingBrotli CompressionEncoding = "Brotli"
+
+	// CompressionEncodingDeflate compresses responses using the "deflate"
+	// content coding.
+	CompressionEncodingDeflate CompressionEncoding = "Deflate"
+)
+
 // HTTPBackendRef defines how a HTTPRoute forwards a HTTP request.
 //
 // Note that when a namespace different than the local namespace is specified, a
@@ -1222,6 +1866,24 @@ type HTTPBackendRef struct {
 	// +kubebuilder:validation:XValidation:message="ResponseHeaderModifier filter cannot be repeated",rule="self.filter(f, f.type == 'ResponseHeaderModifier').size() <= 1"
 	// +kubebuilder:validation:XValidation:message="RequestRedirect filter cannot be repeated",rule="self.filter(f, f.type == 'RequestRedirect').size() <= 1"
 	// +kubebuilder:validation:XValidation:message="URLRewrite filter cannot be repeated",rule="self.filter(f, f.type == 'URLRewrite').size() <= 1"
+	// <gateway:experimental:validation:XValidation:message="RequestBodyLimit filter cannot be repeated",rule="self.filter(f, f.type == 'RequestBodyLimit').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.requestBodyLimit must be nil if the filter.type is not RequestBodyLimit",rule="self.all(f, !(has(f.requestBodyLimit) && f.type != 'RequestBodyLimit'))">
+	// <gateway:experimental:validation:XValidation:message="filter.requestBodyLimit must be specified for RequestBodyLimit filter.type",rule="self.all(f, !(!has(f.requestBodyLimit) && f.type == 'RequestBodyLimit'))">
+	// <gateway:experimental:validation:XValidation:message="ResponseCompression filter cannot be repeated",rule="self.filter(f, f.type == 'ResponseCompression').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.responseCompression must be nil if the filter.type is not ResponseCompression",rule="self.all(f, !(has(f.responseCompression) && f.type != 'ResponseCompression'))">
+	// <gateway:experimental:validation:XValidation:message="filter.responseCompression must be specified for ResponseCompression filter.type",rule="self.all(f, !(!has(f.responseCompression) && f.type == 'ResponseCompression'))">
+	// <gateway:experimental:validation:XValidation:message="JWTAuth filter cannot be repeated",rule="self.filter(f, f.type == 'JWTAuth').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.jwtAuth must be nil if the filter.type is not JWTAuth",rule="self.all(f, !(has(f.jwtAuth) && f.type != 'JWTAuth'))">
+	// <gateway:experimental:validation:XValidation:message="filter.jwtAuth must be specified for JWTAuth filter.type",rule="self.all(f, !(!has(f.jwtAuth) && f.type == 'JWTAuth'))">
+	// <gateway:experimental:validation:XValidation:message="CircuitBreaker filter cannot be repeated",rule="self.filter(f, f.type == 'CircuitBreaker').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.circuitBreaker must be nil if the filter.type is not CircuitBreaker",rule="self.all(f, !(has(f.circuitBreaker) && f.type != 'CircuitBreaker'))">
+	// <gateway:experimental:validation:XValidation:message="filter.circuitBreaker must be specified for CircuitBreaker filter.type",rule="self.all(f, !(!has(f.circuitBreaker) && f.type == 'CircuitBreaker'))">
+	// <gateway:experimental:validation:XValidation:message="ResponseCache filter cannot be repeated",rule="self.filter(f, f.type == 'ResponseCache').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.responseCache must be nil if the filter.type is not ResponseCache",rule="self.all(f, !(has(f.responseCache) && f.type != 'ResponseCache'))">
+	// <gateway:experimental:validation:XValidation:message="filter.responseCache must be specified for ResponseCache filter.type",rule="self.all(f, !(!has(f.responseCache) && f.type == 'ResponseCache'))">
+	// <gateway:experimental:validation:XValidation:message="GRPCTranscoding filter cannot be repeated",rule="self.filter(f, f.type == 'GRPCTranscoding').size() <= 1">
+	// <gateway:experimental:validation:XValidation:message="filter.grpcTranscoding must be nil if the filter.type is not GRPCTranscoding",rule="self.all(f, !(has(f.grpcTranscoding) && f.type != 'GRPCTranscoding'))">
+	// <gateway:experimental:validation:XValidation:message="filter.grpcTranscoding must be specified for GRPCTranscoding filter.type",rule="self.all(f, !(!has(f.grpcTranscoding) && f.type == 'GRPCTranscoding'))">
 	Filters []HTTPRouteFilter `json:"filters,omitempty"`
 }
 
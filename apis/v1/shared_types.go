@@ -431,6 +431,51 @@ const (
 	RouteConditionPartiallyInvalid RouteConditionType = "PartiallyInvalid"
 )
 
+const (
+	// This condition indicates that the effective weight of at least one of
+	// the Route's BackendRefs has been reduced because the backend is
+	// unhealthy and the rule has AutoWeightAdjustment enabled.
+	//
+	// This condition MUST only be set when it is "True", and MUST be removed
+	// once all effective weights match their configured weights.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "UnhealthyBackends"
+	//
+	// Controllers may raise this condition with other reasons, but should
+	// prefer to use the reasons listed above to improve interoperability.
+	RouteConditionAutoWeightAdjustmentActive RouteConditionType = "AutoWeightAdjustmentActive"
+
+	// This reason is used with the "AutoWeightAdjustmentActive" condition
+	// when the effective weight of one or more backends has been reduced
+	// because they failed health checks.
+	RouteReasonUnhealthyBackends RouteConditionReason = "UnhealthyBackends"
+)
+
+const (
+	// This condition indicates that the circuit of at least one of the
+	// Route's BackendRefs is open because of a CircuitBreaker filter, and
+	// traffic is not being sent to it. The message SHOULD list the affected
+	// BackendRefs.
+	//
+	// This condition MUST only be set when it is "True", and MUST be removed
+	// once all circuits are closed again.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "BackendFailures"
+	//
+	// Controllers may raise this condition with other reasons, but should
+	// prefer to use the reasons listed above to improve interoperability.
+	RouteConditionCircuitBreakerOpen RouteConditionType = "CircuitBreakerOpen"
+
+	// This reason is used with the "CircuitBreakerOpen" condition when a
+	// backend exceeded the number of failures configured in its
+	// CircuitBreaker filter.
+	RouteReasonBackendFailures RouteConditionReason = "BackendFailures"
+)
+
 // RouteParentStatus describes the status of a route with respect to an
 // associated Parent.
 type RouteParentStatus struct {
@@ -478,6 +523,27 @@ type RouteParentStatus struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=8
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ResolvedBackends is the number of BackendRefs of the Route that were
+	// successfully resolved by this parent. When it is lower than
+	// TotalBackends, the "ResolvedRefs" condition MUST be set to false.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	// +kubebuilder:validation:Minimum=0
+	ResolvedBackends *int32 `json:"resolvedBackends,omitempty"`
+
+	// TotalBackends is the number of BackendRefs of the Route considered by
+	// this parent.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	// +kubebuilder:validation:Minimum=0
+	TotalBackends *int32 `json:"totalBackends,omitempty"`
 }
 
 // RouteStatus defines the common attributes that all Routes MUST include within
@@ -0,0 +1,40 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// MergeResourceAnnotations returns the annotations that should be applied to
+// infrastructure provisioned for a Gateway. The extra annotations, typically
+// supplied by the implementation, are merged with the ResourceAnnotations of
+// the given infrastructure, with ResourceAnnotations taking precedence. The
+// inputs are not modified.
+func MergeResourceAnnotations(infra *gatewayv1.GatewayInfrastructure, extra map[string]string) map[string]string {
+	merged := make(map[string]string, len(extra))
+	for k, v := range extra {
+		merged[k] = v
+	}
+	if infra == nil {
+		return merged
+	}
+	for k, v := range infra.ResourceAnnotations {
+		merged[string(k)] = string(v)
+	}
+	return merged
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/gateway"
+)
+
+func TestMergeResourceAnnotations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		infra    *gatewayv1.GatewayInfrastructure
+		extra    map[string]string
+		expected map[string]string
+	}{{
+		name:     "nil infrastructure and extra",
+		expected: map[string]string{},
+	}, {
+		name:     "nil infrastructure",
+		extra:    map[string]string{"example.com/foo": "bar"},
+		expected: map[string]string{"example.com/foo": "bar"},
+	}, {
+		name: "only resource annotations",
+		infra: &gatewayv1.GatewayInfrastructure{
+			ResourceAnnotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				"example.com/foo": "bar",
+			},
+		},
+		expected: map[string]string{"example.com/foo": "bar"},
+	}, {
+		name: "annotations are not included",
+		infra: &gatewayv1.GatewayInfrastructure{
+			Annotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				"example.com/object": "value",
+			},
+			ResourceAnnotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				"example.com/foo": "bar",
+			},
+		},
+		expected: map[string]string{"example.com/foo": "bar"},
+	}, {
+		name: "resource annotations take precedence",
+		infra: &gatewayv1.GatewayInfrastructure{
+			ResourceAnnotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				"example.com/foo": "from-gateway",
+			},
+		},
+		extra: map[string]string{
+			"example.com/foo": "from-implementation",
+			"example.com/baz": "qux",
+		},
+		expected: map[string]string{
+			"example.com/foo": "from-gateway",
+			"example.com/baz": "qux",
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var extraCopy map[string]string
+			if tc.extra != nil {
+				extraCopy = make(map[string]string, len(tc.extra))
+				for k, v := range tc.extra {
+					extraCopy[k] = v
+				}
+			}
+
+			require.Equal(t, tc.expected, gateway.MergeResourceAnnotations(tc.infra, tc.extra))
+			require.Equal(t, extraCopy, tc.extra, "extra annotations must not be modified")
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// DefaultAllowedKindsForProtocol returns the kinds of Routes selected for a
+// Listener with the provided protocol when its AllowedRoutes.Kinds is
+// unspecified or empty. It returns nil for protocols not defined by this API,
+// such as implementation-specific protocols.
+//
+// For TLS listeners both TLSRoute and TCPRoute are returned, as the kind that
+// can attach depends on the TLS mode: TLSRoute for Passthrough and TCPRoute
+// for Terminate.
+//
+// Implementations should only report the returned kinds they support in the
+// SupportedKinds of the Listener status.
+func DefaultAllowedKindsForProtocol(protocol gatewayv1.ProtocolType) []gatewayv1.RouteGroupKind {
+	switch protocol {
+	case gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType:
+		return routeGroupKinds("HTTPRoute", "GRPCRoute")
+	case gatewayv1.TLSProtocolType:
+		return routeGroupKinds("TLSRoute", "TCPRoute")
+	case gatewayv1.TCPProtocolType:
+		return routeGroupKinds("TCPRoute")
+	case gatewayv1.UDPProtocolType:
+		return routeGroupKinds("UDPRoute")
+	}
+	return nil
+}
+
+func routeGroupKinds(kinds ...gatewayv1.Kind) []gatewayv1.RouteGroupKind {
+	rgks := make([]gatewayv1.RouteGroupKind, 0, len(kinds))
+	for _, kind := range kinds {
+		group := gatewayv1.Group(gatewayv1.GroupName)
+		rgks = append(rgks, gatewayv1.RouteGroupKind{Group: &group, Kind: kind})
+	}
+	return rgks
+}
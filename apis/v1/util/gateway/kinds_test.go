@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/gateway"
+)
+
+func TestDefaultAllowedKindsForProtocol(t *testing.T) {
+	testCases := []struct {
+		name     string
+		protocol gatewayv1.ProtocolType
+		expected []gatewayv1.Kind
+	}{{
+		name:     "HTTP",
+		protocol: gatewayv1.HTTPProtocolType,
+		expected: []gatewayv1.Kind{"HTTPRoute", "GRPCRoute"},
+	}, {
+		name:     "HTTPS",
+		protocol: gatewayv1.HTTPSProtocolType,
+		expected: []gatewayv1.Kind{"HTTPRoute", "GRPCRoute"},
+	}, {
+		name:     "TLS",
+		protocol: gatewayv1.TLSProtocolType,
+		expected: []gatewayv1.Kind{"TLSRoute", "TCPRoute"},
+	}, {
+		name:     "TCP",
+		protocol: gatewayv1.TCPProtocolType,
+		expected: []gatewayv1.Kind{"TCPRoute"},
+	}, {
+		name:     "UDP",
+		protocol: gatewayv1.UDPProtocolType,
+		expected: []gatewayv1.Kind{"UDPRoute"},
+	}, {
+		name:     "implementation-specific protocol",
+		protocol: "example.com/custom",
+		expected: nil,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rgks := gateway.DefaultAllowedKindsForProtocol(tc.protocol)
+			var kinds []gatewayv1.Kind
+			for _, rgk := range rgks {
+				require.NotNil(t, rgk.Group)
+				require.Equal(t, gatewayv1.Group(gatewayv1.GroupName), *rgk.Group)
+				kinds = append(kinds, rgk.Kind)
+			}
+			require.Equal(t, tc.expected, kinds)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespaceSelectorMatchesAll reports whether the provided label selector
+// matches all namespaces, which is the case for a non-nil selector without
+// any matchLabels or matchExpressions. A nil selector matches no namespaces.
+//
+// Listeners using "Selector" for AllowedRoutes should use "All" instead of an
+// empty selector to select all namespaces.
+func NamespaceSelectorMatchesAll(sel *metav1.LabelSelector) bool {
+	return sel != nil && len(sel.MatchLabels) == 0 && len(sel.MatchExpressions) == 0
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/gateway-api/apis/v1/util/gateway"
+)
+
+func TestNamespaceSelectorMatchesAll(t *testing.T) {
+	testCases := []struct {
+		name     string
+		selector *metav1.LabelSelector
+		expected bool
+	}{{
+		name:     "nil selector",
+		selector: nil,
+		expected: false,
+	}, {
+		name:     "empty selector",
+		selector: &metav1.LabelSelector{},
+		expected: true,
+	}, {
+		name: "empty matchLabels and matchExpressions",
+		selector: &metav1.LabelSelector{
+			MatchLabels:      map[string]string{},
+			MatchExpressions: []metav1.LabelSelectorRequirement{},
+		},
+		expected: true,
+	}, {
+		name: "matchLabels",
+		selector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"kubernetes.io/metadata.name": "foo"},
+		},
+		expected: false,
+	}, {
+		name: "matchExpressions",
+		selector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      "env",
+				Operator: metav1.LabelSelectorOpExists,
+			}},
+		},
+		expected: false,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, gateway.NamespaceSelectorMatchesAll(tc.selector))
+		})
+	}
+}
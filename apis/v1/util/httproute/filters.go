@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// NewHTTPSRedirectFilter returns a RequestRedirect filter that permanently
+// redirects requests to the same location using the https scheme, which is
+// the most common way of upgrading plain HTTP traffic.
+func NewHTTPSRedirectFilter() gatewayv1.HTTPRouteFilter {
+	scheme := "https"
+	statusCode := 301
+	return gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+			Scheme:     &scheme,
+			StatusCode: &statusCode,
+		},
+	}
+}
+
+// NewBodyLimitFilter returns a RequestBodyLimit filter that rejects requests
+// with a body larger than maxBytes.
+func NewBodyLimitFilter(maxBytes int64) gatewayv1.HTTPRouteFilter {
+	return gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestBodyLimit,
+		RequestBodyLimit: &gatewayv1.HTTPRequestBodyLimitFilter{
+			MaxBytes: maxBytes,
+		},
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/httproute"
+)
+
+func ptrTo[T any](a T) *T {
+	return &a
+}
+
+func TestNewHTTPSRedirectFilter(t *testing.T) {
+	expected := gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+			Scheme:     ptrTo("https"),
+			StatusCode: ptrTo(301),
+		},
+	}
+	require.Equal(t, expected, httproute.NewHTTPSRedirectFilter())
+
+	// Each call must return an independent filter.
+	filter := httproute.NewHTTPSRedirectFilter()
+	*filter.RequestRedirect.Scheme = "http"
+	require.Equal(t, expected, httproute.NewHTTPSRedirectFilter())
+}
+
+func TestNewBodyLimitFilter(t *testing.T) {
+	expected := gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestBodyLimit,
+		RequestBodyLimit: &gatewayv1.HTTPRequestBodyLimitFilter{
+			MaxBytes: 1024,
+		},
+	}
+	require.Equal(t, expected, httproute.NewBodyLimitFilter(1024))
+}
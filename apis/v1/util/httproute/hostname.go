@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	"strings"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// HostnamesIntersect reports whether at least one of the given HTTPRoute
+// hostnames intersects with the hostname of a Listener. Wildcard hostnames
+// (`*.`) are supported on both sides and are interpreted as a suffix match
+// that requires at least one additional label. A nil or empty Listener
+// hostname, or an empty list of Route hostnames, matches all hostnames.
+//
+// Routes for which this returns false must not be attached to the Listener
+// and should report the "NoMatchingListenerHostname" reason on the
+// "Accepted" condition.
+func HostnamesIntersect(routeHostnames []gatewayv1.Hostname, listenerHostname *gatewayv1.Hostname) bool {
+	if listenerHostname == nil || *listenerHostname == "" || len(routeHostnames) == 0 {
+		return true
+	}
+	for _, routeHostname := range routeHostnames {
+		if hostnameIntersects(string(routeHostname), string(*listenerHostname)) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostnameIntersects reports whether two hostnames, either of which may be a
+// wildcard, can match the same request hostname.
+func hostnameIntersects(a, b string) bool {
+	aWildcard := strings.HasPrefix(a, "*.")
+	bWildcard := strings.HasPrefix(b, "*.")
+
+	switch {
+	case !aWildcard && !bWildcard:
+		return a == b
+	case aWildcard && !bWildcard:
+		return strings.HasSuffix(b, a[1:])
+	case !aWildcard && bWildcard:
+		return strings.HasSuffix(a, b[1:])
+	default:
+		return strings.HasSuffix(a[1:], b[1:]) || strings.HasSuffix(b[1:], a[1:])
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/httproute"
+)
+
+func TestHostnamesIntersect(t *testing.T) {
+	testCases := []struct {
+		name             string
+		routeHostnames   []gatewayv1.Hostname
+		listenerHostname *gatewayv1.Hostname
+		expected         bool
+	}{{
+		name:             "no listener hostname",
+		routeHostnames:   []gatewayv1.Hostname{"foo.example.com"},
+		listenerHostname: nil,
+		expected:         true,
+	}, {
+		name:             "empty listener hostname",
+		routeHostnames:   []gatewayv1.Hostname{"foo.example.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("")),
+		expected:         true,
+	}, {
+		name:             "no route hostnames",
+		routeHostnames:   nil,
+		listenerHostname: ptrTo(gatewayv1.Hostname("foo.example.com")),
+		expected:         true,
+	}, {
+		name:             "equal exact hostnames",
+		routeHostnames:   []gatewayv1.Hostname{"foo.example.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("foo.example.com")),
+		expected:         true,
+	}, {
+		name:             "different exact hostnames",
+		routeHostnames:   []gatewayv1.Hostname{"bar.example.com", "foo.example.net"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("foo.example.com")),
+		expected:         false,
+	}, {
+		name:             "one of several route hostnames matches",
+		routeHostnames:   []gatewayv1.Hostname{"foo.example.net", "foo.example.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("foo.example.com")),
+		expected:         true,
+	}, {
+		name:             "wildcard listener matches exact route hostname",
+		routeHostnames:   []gatewayv1.Hostname{"foo.bar.example.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("*.example.com")),
+		expected:         true,
+	}, {
+		name:             "wildcard listener does not match its own domain",
+		routeHostnames:   []gatewayv1.Hostname{"example.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("*.example.com")),
+		expected:         false,
+	}, {
+		name:             "wildcard listener requires a label boundary",
+		routeHostnames:   []gatewayv1.Hostname{"fooexample.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("*.example.com")),
+		expected:         false,
+	}, {
+		name:             "wildcard route matches exact listener hostname",
+		routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("foo.example.com")),
+		expected:         true,
+	}, {
+		name:             "wildcard route does not match different domain",
+		routeHostnames:   []gatewayv1.Hostname{"*.example.net"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("foo.example.com")),
+		expected:         false,
+	}, {
+		name:             "narrower wildcard route matches wildcard listener",
+		routeHostnames:   []gatewayv1.Hostname{"*.foo.example.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("*.example.com")),
+		expected:         true,
+	}, {
+		name:             "broader wildcard route matches wildcard listener",
+		routeHostnames:   []gatewayv1.Hostname{"*.com"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("*.example.com")),
+		expected:         true,
+	}, {
+		name:             "unrelated wildcards",
+		routeHostnames:   []gatewayv1.Hostname{"*.example.net"},
+		listenerHostname: ptrTo(gatewayv1.Hostname("*.example.com")),
+		expected:         false,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, httproute.HostnamesIntersect(tc.routeHostnames, tc.listenerHostname))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	"fmt"
+	"strings"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// LintWarning describes a valid but likely unintended configuration found in
+// an HTTPRoute.
+type LintWarning struct {
+	// Field is the path of the offending field, for example
+	// `spec.rules[0].matches[1].path.value`.
+	Field string
+
+	// Value is the value of the offending field.
+	Value string
+
+	// Suggestion describes how the configuration can be made unambiguous.
+	Suggestion string
+}
+
+// LintHTTPRouteMatches returns a warning for each path match in the provided
+// HTTPRoute that is accepted by the API but is known to be confusing.
+// Implementations may surface these warnings to users, for example in the
+// message of a Route condition, without rejecting the Route.
+func LintHTTPRouteMatches(route *gatewayv1.HTTPRoute) []LintWarning {
+	var warnings []LintWarning
+	for i, rule := range route.Spec.Rules {
+		for j, match := range rule.Matches {
+			if match.Path == nil {
+				continue
+			}
+			field := fmt.Sprintf("spec.rules[%d].matches[%d].path.value", i, j)
+			if warning, ok := lintPathMatch(*match.Path); ok {
+				warning.Field = field
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+	return warnings
+}
+
+// lintPathMatch returns a warning for the provided path match, if any.
+func lintPathMatch(path gatewayv1.HTTPPathMatch) (LintWarning, bool) {
+	pathType := gatewayv1.PathMatchPathPrefix
+	if path.Type != nil {
+		pathType = *path.Type
+	}
+	value := "/"
+	if path.Value != nil {
+		value = *path.Value
+	}
+
+	switch pathType {
+	case gatewayv1.PathMatchExact:
+		if value == "" {
+			return LintWarning{Value: value, Suggestion: `use "/" to match the root path`}, true
+		}
+		if len(value) > 1 && strings.HasSuffix(value, "/") && (path.NormalizeTrailingSlash == nil || !*path.NormalizeTrailingSlash) {
+			return LintWarning{
+				Value:      value,
+				Suggestion: fmt.Sprintf("an Exact match with a trailing slash does not match %q, remove the trailing slash or use PathPrefix", strings.TrimSuffix(value, "/")),
+			}, true
+		}
+	case gatewayv1.PathMatchPathPrefix:
+		if value == "" {
+			return LintWarning{Value: value, Suggestion: `use "/" to match all paths`}, true
+		}
+		if len(value) > 1 && strings.HasSuffix(value, "/") {
+			return LintWarning{
+				Value:      value,
+				Suggestion: fmt.Sprintf("a trailing slash is ignored for PathPrefix matches, use %q instead", strings.TrimSuffix(value, "/")),
+			}, true
+		}
+	}
+	return LintWarning{}, false
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/httproute"
+)
+
+func TestLintHTTPRouteMatches(t *testing.T) {
+	pathMatch := func(pathType gatewayv1.PathMatchType, value string) gatewayv1.HTTPRouteMatch {
+		return gatewayv1.HTTPRouteMatch{Path: &gatewayv1.HTTPPathMatch{Type: &pathType, Value: &value}}
+	}
+
+	testCases := []struct {
+		name     string
+		matches  []gatewayv1.HTTPRouteMatch
+		expected []httproute.LintWarning
+	}{{
+		name:    "idiomatic matches",
+		matches: []gatewayv1.HTTPRouteMatch{pathMatch(gatewayv1.PathMatchExact, "/foo"), pathMatch(gatewayv1.PathMatchPathPrefix, "/"), {}},
+	}, {
+		name:    "exact match with trailing slash",
+		matches: []gatewayv1.HTTPRouteMatch{pathMatch(gatewayv1.PathMatchExact, "/foo"), pathMatch(gatewayv1.PathMatchExact, "/foo/")},
+		expected: []httproute.LintWarning{{
+			Field:      "spec.rules[0].matches[1].path.value",
+			Value:      "/foo/",
+			Suggestion: `an Exact match with a trailing slash does not match "/foo", remove the trailing slash or use PathPrefix`,
+		}},
+	}, {
+		name: "exact match with trailing slash normalized",
+		matches: []gatewayv1.HTTPRouteMatch{{Path: &gatewayv1.HTTPPathMatch{
+			Type:                   ptrTo(gatewayv1.PathMatchExact),
+			Value:                  ptrTo("/foo/"),
+			NormalizeTrailingSlash: ptrTo(true),
+		}}},
+	}, {
+		name:    "prefix match with trailing slash",
+		matches: []gatewayv1.HTTPRouteMatch{pathMatch(gatewayv1.PathMatchPathPrefix, "/foo/")},
+		expected: []httproute.LintWarning{{
+			Field:      "spec.rules[0].matches[0].path.value",
+			Value:      "/foo/",
+			Suggestion: `a trailing slash is ignored for PathPrefix matches, use "/foo" instead`,
+		}},
+	}, {
+		name:    "prefix match with empty path",
+		matches: []gatewayv1.HTTPRouteMatch{pathMatch(gatewayv1.PathMatchPathPrefix, "")},
+		expected: []httproute.LintWarning{{
+			Field:      "spec.rules[0].matches[0].path.value",
+			Value:      "",
+			Suggestion: `use "/" to match all paths`,
+		}},
+	}, {
+		name:    "regular expression is not linted",
+		matches: []gatewayv1.HTTPRouteMatch{pathMatch(gatewayv1.PathMatchRegularExpression, "/foo/")},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			route := &gatewayv1.HTTPRoute{
+				Spec: gatewayv1.HTTPRouteSpec{
+					Rules: []gatewayv1.HTTPRouteRule{{Matches: tc.matches}},
+				},
+			}
+			require.Equal(t, tc.expected, httproute.LintHTTPRouteMatches(route))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package httproute provides helpers for implementations processing HTTPRoute
+// resources.
+package httproute
+
+import (
+	"strings"
+)
+
+// PathNormalizationMode controls how a trailing slash is handled by
+// NormalizePathForMatch.
+type PathNormalizationMode string
+
+const (
+	// PathNormalizationModeNone leaves a trailing slash untouched.
+	PathNormalizationModeNone PathNormalizationMode = "None"
+
+	// PathNormalizationModeStripTrailingSlash removes a trailing slash from
+	// any path other than `/`. Implementations honoring
+	// HTTPPathMatch.NormalizeTrailingSlash can normalize both the match value
+	// and the request path with this mode before comparing them.
+	PathNormalizationModeStripTrailingSlash PathNormalizationMode = "StripTrailingSlash"
+
+	// PathNormalizationModeAppendTrailingSlash appends a trailing slash to any
+	// path that does not already end with one.
+	PathNormalizationModeAppendTrailingSlash PathNormalizationMode = "AppendTrailingSlash"
+)
+
+// NormalizePathForMatch normalizes the provided path following the
+// syntax-based normalization rules of RFC 3986, section 6.2.2: hexadecimal
+// digits of percent-encoded octets are uppercased, percent-encoded unreserved
+// characters are decoded, and dot-segments are removed. The trailing slash is
+// then handled according to mode.
+func NormalizePathForMatch(path string, mode PathNormalizationMode) string {
+	path = removeDotSegments(normalizePercentEncoding(path))
+
+	switch mode {
+	case PathNormalizationModeStripTrailingSlash:
+		if len(path) > 1 {
+			path = strings.TrimSuffix(path, "/")
+		}
+	case PathNormalizationModeAppendTrailingSlash:
+		if !strings.HasSuffix(path, "/") {
+			path += "/"
+		}
+	}
+	return path
+}
+
+// NormalizePath returns the provided path lowercased when caseInsensitive is
+// true, and unchanged otherwise. Implementations honoring
+// HTTPPathMatch.CaseInsensitive can normalize both the match value and the
+// request path before comparing them.
+func NormalizePath(path string, caseInsensitive bool) string {
+	if !caseInsensitive {
+		return path
+	}
+	return strings.ToLower(path)
+}
+
+// normalizePercentEncoding implements RFC 3986, sections 6.2.2.1 and 6.2.2.2.
+func normalizePercentEncoding(path string) string {
+	if !strings.Contains(path, "%") {
+		return path
+	}
+
+	var b strings.Builder
+	b.Grow(len(path))
+	for i := 0; i < len(path); i++ {
+		if path[i] == '%' && i+2 < len(path) && isHex(path[i+1]) && isHex(path[i+2]) {
+			c := unhex(path[i+1])<<4 | unhex(path[i+2])
+			if isUnreserved(c) {
+				b.WriteByte(c)
+			} else {
+				b.WriteByte('%')
+				b.WriteString(strings.ToUpper(path[i+1 : i+3]))
+			}
+			i += 2
+			continue
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
+
+// removeDotSegments implements the algorithm described in RFC 3986, section
+// 5.2.4.
+func removeDotSegments(input string) string {
+	var output strings.Builder
+	output.Grow(len(input))
+	for len(input) > 0 {
+		switch {
+		case strings.HasPrefix(input, "../"):
+			input = input[3:]
+		case strings.HasPrefix(input, "./"):
+			input = input[2:]
+		case strings.HasPrefix(input, "/./"):
+			input = input[2:]
+		case input == "/.":
+			input = "/"
+		case strings.HasPrefix(input, "/../"):
+			input = input[3:]
+			removeLastSegment(&output)
+		case input == "/..":
+			input = "/"
+			removeLastSegment(&output)
+		case input == "." || input == "..":
+			input = ""
+		default:
+			end := strings.IndexByte(input[1:], '/')
+			if end < 0 {
+				end = len(input)
+			} else {
+				end++
+			}
+			output.WriteString(input[:end])
+			input = input[end:]
+		}
+	}
+	return output.String()
+}
+
+func removeLastSegment(output *strings.Builder) {
+	s := output.String()
+	idx := strings.LastIndexByte(s, '/')
+	if idx < 0 {
+		idx = 0
+	}
+	output.Reset()
+	output.WriteString(s[:idx])
+}
+
+func isUnreserved(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+func isHex(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
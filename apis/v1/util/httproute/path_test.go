@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute_test
+
+import (
+	"testing"
+
+	"sigs.k8s.io/gateway-api/apis/v1/util/httproute"
+)
+
+func TestNormalizePathForMatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     string
+		mode     httproute.PathNormalizationMode
+		expected string
+	}{
+		// Examples from RFC 3986, section 5.2.4.
+		{
+			name:     "remove dot segments",
+			path:     "/a/b/c/./../../g",
+			mode:     httproute.PathNormalizationModeNone,
+			expected: "/a/g",
+		},
+		{
+			name:     "remove dot segments from relative path",
+			path:     "mid/content=5/../6",
+			mode:     httproute.PathNormalizationModeNone,
+			expected: "mid/6",
+		},
+		// Examples from RFC 3986, sections 6.2.2.1 and 6.2.2.2.
+		{
+			name:     "uppercase percent-encoded octets",
+			path:     "/a%c2%b1b",
+			mode:     httproute.PathNormalizationModeNone,
+			expected: "/a%C2%B1b",
+		},
+		{
+			name:     "decode percent-encoded unreserved characters",
+			path:     "/%7Efoo",
+			mode:     httproute.PathNormalizationModeNone,
+			expected: "/~foo",
+		},
+		// Trailing slash handling.
+		{
+			name:     "trailing slash preserved by default",
+			path:     "/foo/",
+			mode:     httproute.PathNormalizationModeNone,
+			expected: "/foo/",
+		},
+		{
+			name:     "strip trailing slash",
+			path:     "/foo/",
+			mode:     httproute.PathNormalizationModeStripTrailingSlash,
+			expected: "/foo",
+		},
+		{
+			name:     "strip trailing slash keeps root",
+			path:     "/",
+			mode:     httproute.PathNormalizationModeStripTrailingSlash,
+			expected: "/",
+		},
+		{
+			name:     "strip trailing slash after dot segment removal",
+			path:     "/foo/bar/..",
+			mode:     httproute.PathNormalizationModeStripTrailingSlash,
+			expected: "/foo",
+		},
+		{
+			name:     "append trailing slash",
+			path:     "/foo",
+			mode:     httproute.PathNormalizationModeAppendTrailingSlash,
+			expected: "/foo/",
+		},
+		{
+			name:     "append trailing slash is idempotent",
+			path:     "/foo/",
+			mode:     httproute.PathNormalizationModeAppendTrailingSlash,
+			expected: "/foo/",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := httproute.NormalizePathForMatch(tc.path, tc.mode)
+			if got != tc.expected {
+				t.Errorf("NormalizePathForMatch(%q, %q) = %q, want %q", tc.path, tc.mode, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizePath(t *testing.T) {
+	testCases := []struct {
+		name            string
+		path            string
+		caseInsensitive bool
+		expected        string
+	}{
+		{
+			name:            "case-sensitive path is unchanged",
+			path:            "/Foo/Bar",
+			caseInsensitive: false,
+			expected:        "/Foo/Bar",
+		},
+		{
+			name:            "case-insensitive path is lowercased",
+			path:            "/Foo/Bar",
+			caseInsensitive: true,
+			expected:        "/foo/bar",
+		},
+		{
+			name:            "case-insensitive lowercase path is unchanged",
+			path:            "/foo/bar",
+			caseInsensitive: true,
+			expected:        "/foo/bar",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := httproute.NormalizePath(tc.path, tc.caseInsensitive)
+			if got != tc.expected {
+				t.Errorf("NormalizePath(%q, %t) = %q, want %q", tc.path, tc.caseInsensitive, got, tc.expected)
+			}
+		})
+	}
+}
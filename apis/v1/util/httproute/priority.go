@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	"sort"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ResolveRoutePriority returns the given HTTPRoutes ordered from highest to
+// lowest precedence. Routes with an explicit Priority come first, ordered by
+// ascending Priority. Remaining ties are broken by the most specific match of
+// each Route, following the precedence defined for HTTPRouteMatches, then by
+// the oldest creation timestamp and then by alphabetical order of
+// "{namespace}/{name}". The input slice is not modified.
+//
+// Routes are compared by their single most specific match, so the result
+// orders whole Routes; callers resolving an individual request still need to
+// compare the matches that actually apply to it.
+func ResolveRoutePriority(routes []*gatewayv1.HTTPRoute) []*gatewayv1.HTTPRoute {
+	sorted := make([]*gatewayv1.HTTPRoute, len(routes))
+	copy(sorted, routes)
+
+	specificity := make(map[*gatewayv1.HTTPRoute]gatewayv1.HTTPRouteMatch, len(sorted))
+	for _, route := range sorted {
+		specificity[route] = mostSpecificMatch(route)
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+
+		switch {
+		case a.Spec.Priority != nil && b.Spec.Priority == nil:
+			return true
+		case a.Spec.Priority == nil && b.Spec.Priority != nil:
+			return false
+		case a.Spec.Priority != nil && *a.Spec.Priority != *b.Spec.Priority:
+			return *a.Spec.Priority < *b.Spec.Priority
+		}
+
+		if c := compareMatchSpecificity(specificity[a], specificity[b]); c != 0 {
+			return c > 0
+		}
+
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+
+		return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+	})
+
+	return sorted
+}
+
+// mostSpecificMatch returns the most specific match across all rules of the
+// given HTTPRoute. Rules without matches, and matches without a path, are
+// treated as matching the "/" PathPrefix, as defaulted by the API server.
+func mostSpecificMatch(route *gatewayv1.HTTPRoute) gatewayv1.HTTPRouteMatch {
+	var best *gatewayv1.HTTPRouteMatch
+	for _, rule := range route.Spec.Rules {
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []gatewayv1.HTTPRouteMatch{{}}
+		}
+		for i := range matches {
+			if best == nil || compareMatchSpecificity(matches[i], *best) > 0 {
+				best = &matches[i]
+			}
+		}
+	}
+	if best == nil {
+		return gatewayv1.HTTPRouteMatch{}
+	}
+	return *best
+}
+
+// compareMatchSpecificity returns a positive number when a is more specific
+// than b, a negative number when b is more specific than a, and 0 when they
+// are equally specific. Precedence is given to an "Exact" path match, then a
+// "PathPrefix" match with the largest number of characters, then a method
+// match, then the largest number of header matches and finally the largest
+// number of query param matches. RegularExpression path matches rank below
+// the other path types, as their precedence is implementation-specific.
+func compareMatchSpecificity(a, b gatewayv1.HTTPRouteMatch) int {
+	aType, aValue := matchPath(a)
+	bType, bValue := matchPath(b)
+	if c := pathTypeRank(aType) - pathTypeRank(bType); c != 0 {
+		return c
+	}
+	if c := len(aValue) - len(bValue); c != 0 {
+		return c
+	}
+
+	if aMethod, bMethod := a.Method != nil, b.Method != nil; aMethod != bMethod {
+		if aMethod {
+			return 1
+		}
+		return -1
+	}
+
+	if c := len(a.Headers) - len(b.Headers); c != 0 {
+		return c
+	}
+	return len(a.QueryParams) - len(b.QueryParams)
+}
+
+func matchPath(match gatewayv1.HTTPRouteMatch) (gatewayv1.PathMatchType, string) {
+	pathType, value := gatewayv1.PathMatchPathPrefix, "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			value = *match.Path.Value
+		}
+	}
+	return pathType, value
+}
+
+func pathTypeRank(pathType gatewayv1.PathMatchType) int {
+	switch pathType {
+	case gatewayv1.PathMatchExact:
+		return 2
+	case gatewayv1.PathMatchPathPrefix:
+		return 1
+	default:
+		return 0
+	}
+}
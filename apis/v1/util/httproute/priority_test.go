@@ -0,0 +1,227 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/httproute"
+)
+
+func TestResolveRoutePriority(t *testing.T) {
+	now := time.Now()
+
+	newRoute := func(namespace, name string, age time.Duration, priority *int32) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				Priority: priority,
+			},
+		}
+	}
+
+	withMatches := func(route *gatewayv1.HTTPRoute, matches ...gatewayv1.HTTPRouteMatch) *gatewayv1.HTTPRoute {
+		route.Spec.Rules = append(route.Spec.Rules, gatewayv1.HTTPRouteRule{Matches: matches})
+		return route
+	}
+	pathMatch := func(pathType gatewayv1.PathMatchType, value string) gatewayv1.HTTPRouteMatch {
+		return gatewayv1.HTTPRouteMatch{
+			Path: &gatewayv1.HTTPPathMatch{Type: &pathType, Value: &value},
+		}
+	}
+	methodMatch := func(match gatewayv1.HTTPRouteMatch) gatewayv1.HTTPRouteMatch {
+		match.Method = ptrTo(gatewayv1.HTTPMethodGet)
+		return match
+	}
+
+	testCases := []struct {
+		name     string
+		routes   []*gatewayv1.HTTPRoute
+		expected []string
+	}{{
+		name:     "no routes",
+		routes:   []*gatewayv1.HTTPRoute{},
+		expected: []string{},
+	}, {
+		name: "lower priority value wins",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("ns", "b", time.Hour, ptrTo(int32(10))),
+			newRoute("ns", "a", time.Hour, ptrTo(int32(0))),
+			newRoute("ns", "c", time.Hour, ptrTo(int32(1000))),
+		},
+		expected: []string{"ns/a", "ns/b", "ns/c"},
+	}, {
+		name: "explicit priority wins over unset priority",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("ns", "old", 2*time.Hour, nil),
+			newRoute("ns", "new", time.Minute, ptrTo(int32(1000))),
+		},
+		expected: []string{"ns/new", "ns/old"},
+	}, {
+		name: "explicit priority wins over creation timestamp",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("ns", "old", 2*time.Hour, ptrTo(int32(5))),
+			newRoute("ns", "new", time.Minute, ptrTo(int32(1))),
+		},
+		expected: []string{"ns/new", "ns/old"},
+	}, {
+		name: "explicit priority wins over match specificity",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "exact", time.Hour, ptrTo(int32(5))), pathMatch(gatewayv1.PathMatchExact, "/api")),
+			withMatches(newRoute("ns", "prefix", time.Hour, ptrTo(int32(1))), pathMatch(gatewayv1.PathMatchPathPrefix, "/")),
+		},
+		expected: []string{"ns/prefix", "ns/exact"},
+	}, {
+		name: "exact path match wins over prefix match",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "prefix", 2*time.Hour, nil), pathMatch(gatewayv1.PathMatchPathPrefix, "/api/v1")),
+			withMatches(newRoute("ns", "exact", time.Minute, nil), pathMatch(gatewayv1.PathMatchExact, "/api")),
+		},
+		expected: []string{"ns/exact", "ns/prefix"},
+	}, {
+		name: "longest prefix match wins",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "short", 2*time.Hour, nil), pathMatch(gatewayv1.PathMatchPathPrefix, "/api")),
+			withMatches(newRoute("ns", "long", time.Minute, nil), pathMatch(gatewayv1.PathMatchPathPrefix, "/api/v1")),
+		},
+		expected: []string{"ns/long", "ns/short"},
+	}, {
+		name: "regular expression match ranks below prefix match",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "regex", 2*time.Hour, nil), pathMatch(gatewayv1.PathMatchRegularExpression, "/api/.*")),
+			withMatches(newRoute("ns", "prefix", time.Minute, nil), pathMatch(gatewayv1.PathMatchPathPrefix, "/")),
+		},
+		expected: []string{"ns/prefix", "ns/regex"},
+	}, {
+		name: "method match wins over header and query param matches",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "headers", 2*time.Hour, nil), gatewayv1.HTTPRouteMatch{
+				Headers:     []gatewayv1.HTTPHeaderMatch{{Name: "version", Value: "one"}},
+				QueryParams: []gatewayv1.HTTPQueryParamMatch{{Name: "animal", Value: "whale"}},
+			}),
+			withMatches(newRoute("ns", "method", time.Minute, nil), methodMatch(gatewayv1.HTTPRouteMatch{})),
+		},
+		expected: []string{"ns/method", "ns/headers"},
+	}, {
+		name: "most header matches wins over query param matches",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "query", 2*time.Hour, nil), gatewayv1.HTTPRouteMatch{
+				QueryParams: []gatewayv1.HTTPQueryParamMatch{{Name: "animal", Value: "whale"}, {Name: "color", Value: "blue"}},
+			}),
+			withMatches(newRoute("ns", "header", time.Minute, nil), gatewayv1.HTTPRouteMatch{
+				Headers: []gatewayv1.HTTPHeaderMatch{{Name: "version", Value: "one"}},
+			}),
+		},
+		expected: []string{"ns/header", "ns/query"},
+	}, {
+		name: "most query param matches wins",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "one", 2*time.Hour, nil), gatewayv1.HTTPRouteMatch{
+				QueryParams: []gatewayv1.HTTPQueryParamMatch{{Name: "animal", Value: "whale"}},
+			}),
+			withMatches(newRoute("ns", "two", time.Minute, nil), gatewayv1.HTTPRouteMatch{
+				QueryParams: []gatewayv1.HTTPQueryParamMatch{{Name: "animal", Value: "whale"}, {Name: "color", Value: "blue"}},
+			}),
+		},
+		expected: []string{"ns/two", "ns/one"},
+	}, {
+		name: "routes are compared by their most specific match",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "prefix", 2*time.Hour, nil), pathMatch(gatewayv1.PathMatchPathPrefix, "/api/v1/users")),
+			withMatches(withMatches(newRoute("ns", "mixed", time.Minute, nil), pathMatch(gatewayv1.PathMatchPathPrefix, "/")), pathMatch(gatewayv1.PathMatchExact, "/health")),
+		},
+		expected: []string{"ns/mixed", "ns/prefix"},
+	}, {
+		name: "oldest route wins when match specificity is equal",
+		routes: []*gatewayv1.HTTPRoute{
+			withMatches(newRoute("ns", "a", time.Minute, nil), pathMatch(gatewayv1.PathMatchPathPrefix, "/api")),
+			withMatches(newRoute("ns", "b", time.Hour, nil), pathMatch(gatewayv1.PathMatchPathPrefix, "/api")),
+		},
+		expected: []string{"ns/b", "ns/a"},
+	}, {
+		name: "oldest route wins when priority is unset",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("ns", "a", time.Minute, nil),
+			newRoute("ns", "b", time.Hour, nil),
+		},
+		expected: []string{"ns/b", "ns/a"},
+	}, {
+		name: "oldest route wins when priority is equal",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("ns", "a", time.Minute, ptrTo(int32(5))),
+			newRoute("ns", "b", time.Hour, ptrTo(int32(5))),
+		},
+		expected: []string{"ns/b", "ns/a"},
+	}, {
+		name: "namespace breaks ties for routes of the same age",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("ns-b", "a", time.Hour, nil),
+			newRoute("ns-a", "b", time.Hour, nil),
+		},
+		expected: []string{"ns-a/b", "ns-b/a"},
+	}, {
+		name: "ties are broken on the joined namespace and name",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("a", "z", time.Hour, nil),
+			newRoute("a-b", "x", time.Hour, nil),
+		},
+		expected: []string{"a-b/x", "a/z"},
+	}, {
+		name: "name breaks ties within the same namespace",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("ns", "b", time.Hour, ptrTo(int32(5))),
+			newRoute("ns", "a", time.Hour, ptrTo(int32(5))),
+		},
+		expected: []string{"ns/a", "ns/b"},
+	}, {
+		name: "all tie-breakers combined",
+		routes: []*gatewayv1.HTTPRoute{
+			newRoute("ns", "unset-new", time.Minute, nil),
+			newRoute("ns", "unset-old", time.Hour, nil),
+			newRoute("ns", "p5-b", time.Hour, ptrTo(int32(5))),
+			newRoute("ns", "p5-a", time.Hour, ptrTo(int32(5))),
+			newRoute("ns", "p5-old", 2*time.Hour, ptrTo(int32(5))),
+			newRoute("ns", "p1", time.Minute, ptrTo(int32(1))),
+		},
+		expected: []string{"ns/p1", "ns/p5-old", "ns/p5-a", "ns/p5-b", "ns/unset-old", "ns/unset-new"},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := make([]*gatewayv1.HTTPRoute, len(tc.routes))
+			copy(original, tc.routes)
+
+			resolved := httproute.ResolveRoutePriority(tc.routes)
+
+			actual := make([]string, 0, len(resolved))
+			for _, route := range resolved {
+				actual = append(actual, route.Namespace+"/"+route.Name)
+			}
+			require.Equal(t, tc.expected, actual)
+			require.Equal(t, original, tc.routes, "input slice must not be modified")
+		})
+	}
+}
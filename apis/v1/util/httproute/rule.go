@@ -0,0 +1,34 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// GetRuleByName returns the rule of the provided HTTPRoute with the given
+// name, along with its current index in the list of rules. The last return
+// value is false if no rule has that name.
+func GetRuleByName(route *gatewayv1.HTTPRoute, name gatewayv1.SectionName) (*gatewayv1.HTTPRouteRule, int, bool) {
+	for i := range route.Spec.Rules {
+		rule := &route.Spec.Rules[i]
+		if rule.Name != nil && *rule.Name == name {
+			return rule, i, true
+		}
+	}
+	return nil, -1, false
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/httproute"
+)
+
+func TestGetRuleByName(t *testing.T) {
+	route := &gatewayv1.HTTPRoute{
+		Spec: gatewayv1.HTTPRouteSpec{
+			Rules: []gatewayv1.HTTPRouteRule{
+				{},
+				{Name: ptrTo(gatewayv1.SectionName("canary"))},
+				{Name: ptrTo(gatewayv1.SectionName("stable"))},
+			},
+		},
+	}
+
+	rule, index, found := httproute.GetRuleByName(route, "stable")
+	require.True(t, found)
+	require.Equal(t, 2, index)
+	require.Same(t, &route.Spec.Rules[2], rule)
+
+	route.Spec.Rules[1], route.Spec.Rules[2] = route.Spec.Rules[2], route.Spec.Rules[1]
+	rule, index, found = httproute.GetRuleByName(route, "stable")
+	require.True(t, found)
+	require.Equal(t, 1, index)
+	require.Equal(t, gatewayv1.SectionName("stable"), *rule.Name)
+
+	rule, index, found = httproute.GetRuleByName(route, "missing")
+	require.False(t, found)
+	require.Equal(t, -1, index)
+	require.Nil(t, rule)
+}
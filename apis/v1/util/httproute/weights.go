@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ApplyBackendOverrides returns a copy of the BackendRefs of the provided
+// rule with the weights from its BackendOverrides applied. An override
+// matches the BackendRefs referencing the same group, kind, namespace, name
+// and port, where an unset namespace is interpreted as routeNamespace.
+// BackendRefs without a matching override keep their configured weight. The
+// rule is not modified.
+func ApplyBackendOverrides(routeNamespace string, rule gatewayv1.HTTPRouteRule) []gatewayv1.HTTPBackendRef {
+	if rule.BackendRefs == nil {
+		return nil
+	}
+
+	overrides := make(map[backendKey]int32, len(rule.BackendOverrides))
+	for _, override := range rule.BackendOverrides {
+		overrides[newBackendKey(routeNamespace, override.BackendObjectReference)] = override.Weight
+	}
+
+	backendRefs := make([]gatewayv1.HTTPBackendRef, 0, len(rule.BackendRefs))
+	for _, backendRef := range rule.BackendRefs {
+		backendRef = *backendRef.DeepCopy()
+		if weight, ok := overrides[newBackendKey(routeNamespace, backendRef.BackendObjectReference)]; ok {
+			backendRef.Weight = &weight
+		}
+		backendRefs = append(backendRefs, backendRef)
+	}
+	return backendRefs
+}
+
+// backendKey identifies the backend referenced by a BackendObjectReference,
+// with its defaults applied.
+type backendKey struct {
+	group     gatewayv1.Group
+	kind      gatewayv1.Kind
+	namespace gatewayv1.Namespace
+	name      gatewayv1.ObjectName
+	port      gatewayv1.PortNumber
+}
+
+func newBackendKey(routeNamespace string, ref gatewayv1.BackendObjectReference) backendKey {
+	key := backendKey{
+		kind:      "Service",
+		namespace: gatewayv1.Namespace(routeNamespace),
+		name:      ref.Name,
+	}
+	if ref.Group != nil {
+		key.group = *ref.Group
+	}
+	if ref.Kind != nil {
+		key.kind = *ref.Kind
+	}
+	if ref.Namespace != nil {
+		key.namespace = *ref.Namespace
+	}
+	if ref.Port != nil {
+		key.port = *ref.Port
+	}
+	return key
+}
+
+// AutoAdjustedWeight returns the effective weight of a backend with the
+// provided configured weight after the given number of consecutive failed
+// health checks, following the algorithm documented on
+// HTTPRouteRule.AutoWeightAdjustment. A weight of 0 is never adjusted.
+func AutoAdjustedWeight(weight int32, consecutiveFailures int) int32 {
+	if weight == 0 || consecutiveFailures < 2 {
+		return weight
+	}
+	for i := 1; i < consecutiveFailures && weight > 1; i++ {
+		weight /= 2
+	}
+	return weight
+}
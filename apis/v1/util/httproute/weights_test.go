@@ -0,0 +1,222 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/httproute"
+)
+
+func TestApplyBackendOverrides(t *testing.T) {
+	backendRef := func(name string, weight *int32) gatewayv1.HTTPBackendRef {
+		return gatewayv1.HTTPBackendRef{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(name),
+					Port: ptrTo(gatewayv1.PortNumber(8080)),
+				},
+				Weight: weight,
+			},
+		}
+	}
+
+	override := func(name string, weight int32) gatewayv1.BackendWeightOverride {
+		return gatewayv1.BackendWeightOverride{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Name: gatewayv1.ObjectName(name),
+				Port: ptrTo(gatewayv1.PortNumber(8080)),
+			},
+			Weight: weight,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		rule     gatewayv1.HTTPRouteRule
+		expected []gatewayv1.HTTPBackendRef
+	}{{
+		name:     "no backendRefs",
+		rule:     gatewayv1.HTTPRouteRule{},
+		expected: nil,
+	}, {
+		name: "no overrides",
+		rule: gatewayv1.HTTPRouteRule{
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				backendRef("foo", ptrTo(int32(70))),
+				backendRef("bar", ptrTo(int32(30))),
+			},
+		},
+		expected: []gatewayv1.HTTPBackendRef{
+			backendRef("foo", ptrTo(int32(70))),
+			backendRef("bar", ptrTo(int32(30))),
+		},
+	}, {
+		name: "override reduces the weight of a degraded backend",
+		rule: gatewayv1.HTTPRouteRule{
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				backendRef("foo", ptrTo(int32(70))),
+				backendRef("bar", ptrTo(int32(30))),
+			},
+			BackendOverrides: []gatewayv1.BackendWeightOverride{
+				override("foo", 10),
+			},
+		},
+		expected: []gatewayv1.HTTPBackendRef{
+			backendRef("foo", ptrTo(int32(10))),
+			backendRef("bar", ptrTo(int32(30))),
+		},
+	}, {
+		name: "override sets the weight of a backendRef without a weight",
+		rule: gatewayv1.HTTPRouteRule{
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				backendRef("foo", nil),
+			},
+			BackendOverrides: []gatewayv1.BackendWeightOverride{
+				override("foo", 0),
+			},
+		},
+		expected: []gatewayv1.HTTPBackendRef{
+			backendRef("foo", ptrTo(int32(0))),
+		},
+	}, {
+		name: "override without a matching backendRef is ignored",
+		rule: gatewayv1.HTTPRouteRule{
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				backendRef("foo", ptrTo(int32(1))),
+			},
+			BackendOverrides: []gatewayv1.BackendWeightOverride{
+				override("baz", 5),
+			},
+		},
+		expected: []gatewayv1.HTTPBackendRef{
+			backendRef("foo", ptrTo(int32(1))),
+		},
+	}, {
+		name: "override only matches backendRefs with the same port",
+		rule: gatewayv1.HTTPRouteRule{
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				backendRef("foo", ptrTo(int32(1))),
+			},
+			BackendOverrides: []gatewayv1.BackendWeightOverride{{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: "foo",
+					Port: ptrTo(gatewayv1.PortNumber(8081)),
+				},
+				Weight: 5,
+			}},
+		},
+		expected: []gatewayv1.HTTPBackendRef{
+			backendRef("foo", ptrTo(int32(1))),
+		},
+	}, {
+		name: "override only matches backendRefs in the same namespace",
+		rule: gatewayv1.HTTPRouteRule{
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				backendRef("foo", ptrTo(int32(1))),
+			},
+			BackendOverrides: []gatewayv1.BackendWeightOverride{{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name:      "foo",
+					Namespace: ptrTo(gatewayv1.Namespace("other")),
+					Port:      ptrTo(gatewayv1.PortNumber(8080)),
+				},
+				Weight: 5,
+			}},
+		},
+		expected: []gatewayv1.HTTPBackendRef{
+			backendRef("foo", ptrTo(int32(1))),
+		},
+	}, {
+		name: "override with explicit defaults matches backendRef without them",
+		rule: gatewayv1.HTTPRouteRule{
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				backendRef("foo", ptrTo(int32(1))),
+			},
+			BackendOverrides: []gatewayv1.BackendWeightOverride{{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Group:     ptrTo(gatewayv1.Group("")),
+					Kind:      ptrTo(gatewayv1.Kind("Service")),
+					Name:      "foo",
+					Namespace: ptrTo(gatewayv1.Namespace("default")),
+					Port:      ptrTo(gatewayv1.PortNumber(8080)),
+				},
+				Weight: 5,
+			}},
+		},
+		expected: []gatewayv1.HTTPBackendRef{
+			backendRef("foo", ptrTo(int32(5))),
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := tc.rule.DeepCopy()
+			require.Equal(t, tc.expected, httproute.ApplyBackendOverrides("default", tc.rule))
+			require.Equal(t, original, &tc.rule, "rule must not be modified")
+		})
+	}
+}
+
+func TestAutoAdjustedWeight(t *testing.T) {
+	testCases := []struct {
+		name                string
+		weight              int32
+		consecutiveFailures int
+		expected            int32
+	}{{
+		name:                "healthy backend",
+		weight:              100,
+		consecutiveFailures: 0,
+		expected:            100,
+	}, {
+		name:                "single failure is not adjusted",
+		weight:              100,
+		consecutiveFailures: 1,
+		expected:            100,
+	}, {
+		name:                "two failures halve the weight",
+		weight:              100,
+		consecutiveFailures: 2,
+		expected:            50,
+	}, {
+		name:                "each further failure halves the weight again",
+		weight:              100,
+		consecutiveFailures: 4,
+		expected:            12,
+	}, {
+		name:                "weight does not drop below one",
+		weight:              3,
+		consecutiveFailures: 10,
+		expected:            1,
+	}, {
+		name:                "zero weight is not adjusted",
+		weight:              0,
+		consecutiveFailures: 5,
+		expected:            0,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, httproute.AutoAdjustedWeight(tc.weight, tc.consecutiveFailures))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// SetGatewayNetworkStatus sets the NetworkStatus of the provided Gateway to a
+// copy of networkStatus, leaving the rest of the Gateway status untouched. A
+// nil networkStatus clears the field. It reports whether the status changed,
+// so callers can skip unnecessary status updates.
+func SetGatewayNetworkStatus(gw *gatewayv1.Gateway, networkStatus *gatewayv1.GatewayNetworkStatus) bool {
+	if equality.Semantic.DeepEqual(gw.Status.NetworkStatus, networkStatus) {
+		return false
+	}
+	gw.Status.NetworkStatus = networkStatus.DeepCopy()
+	return true
+}
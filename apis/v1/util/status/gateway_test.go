@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/status"
+)
+
+func TestSetGatewayNetworkStatus(t *testing.T) {
+	conditions := []metav1.Condition{{
+		Type:   string(gatewayv1.GatewayConditionProgrammed),
+		Status: metav1.ConditionTrue,
+		Reason: string(gatewayv1.GatewayReasonProgrammed),
+	}}
+	gw := &gatewayv1.Gateway{
+		Status: gatewayv1.GatewayStatus{
+			Conditions: conditions,
+		},
+	}
+
+	networkStatus := &gatewayv1.GatewayNetworkStatus{
+		CloudResourceID: "projects/example/regions/us-central1/forwardingRules/gw",
+		Region:          "us-central1",
+	}
+	require.True(t, status.SetGatewayNetworkStatus(gw, networkStatus))
+	require.Equal(t, networkStatus, gw.Status.NetworkStatus)
+	require.Equal(t, conditions, gw.Status.Conditions, "other status fields must not change")
+
+	// The Gateway must hold its own copy of the network status.
+	networkStatus.Zone = "us-central1-a"
+	require.Empty(t, gw.Status.NetworkStatus.Zone)
+
+	require.True(t, status.SetGatewayNetworkStatus(gw, networkStatus))
+	require.Equal(t, "us-central1-a", gw.Status.NetworkStatus.Zone)
+
+	require.False(t, status.SetGatewayNetworkStatus(gw, networkStatus.DeepCopy()), "setting an equal network status must report no change")
+
+	require.True(t, status.SetGatewayNetworkStatus(gw, nil))
+	require.Nil(t, gw.Status.NetworkStatus)
+	require.False(t, status.SetGatewayNetworkStatus(gw, nil))
+}
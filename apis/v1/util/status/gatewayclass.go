@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// SetGatewayClassAccepted sets the "Accepted" condition on the provided
+// GatewayClass, replacing any existing "Accepted" condition. The condition's
+// observedGeneration is set to the GatewayClass generation, and its
+// lastTransitionTime is only updated when the status changes.
+func SetGatewayClassAccepted(class *gatewayv1.GatewayClass, accepted bool, reason gatewayv1.GatewayClassConditionReason, msg string) {
+	status := metav1.ConditionFalse
+	if accepted {
+		status = metav1.ConditionTrue
+	}
+
+	meta.SetStatusCondition(&class.Status.Conditions, metav1.Condition{
+		Type:               string(gatewayv1.GatewayClassConditionStatusAccepted),
+		Status:             status,
+		Reason:             string(reason),
+		Message:            msg,
+		ObservedGeneration: class.Generation,
+	})
+}
+
+// DetectControllerVersionSkew reports whether the ControllerVersion recorded
+// in the status of the provided GatewayClass is older than minSupportedVersion
+// or cannot be parsed. When skew is detected, the "Accepted" condition is set
+// to false with the "UnsupportedVersion" reason. An empty ControllerVersion, or
+// a minSupportedVersion that cannot be parsed, is never considered skewed.
+func DetectControllerVersionSkew(class *gatewayv1.GatewayClass, minSupportedVersion string) bool {
+	if class.Status.ControllerVersion == "" {
+		return false
+	}
+
+	minVersion, err := version.ParseGeneric(minSupportedVersion)
+	if err != nil {
+		return false
+	}
+
+	recorded, err := version.ParseGeneric(class.Status.ControllerVersion)
+	if err == nil && recorded.AtLeast(minVersion) {
+		return false
+	}
+
+	SetGatewayClassAccepted(class, false, gatewayv1.GatewayClassReasonUnsupportedVersion,
+		fmt.Sprintf("GatewayClass was last reconciled by controller version %q, at least %q is required", class.Status.ControllerVersion, minSupportedVersion))
+	return true
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/status"
+)
+
+func TestSetGatewayClassAccepted(t *testing.T) {
+	class := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Generation: 2},
+		Status: gatewayv1.GatewayClassStatus{
+			Conditions: []metav1.Condition{{
+				Type:   string(gatewayv1.GatewayClassConditionStatusAccepted),
+				Status: metav1.ConditionUnknown,
+				Reason: string(gatewayv1.GatewayClassReasonPending),
+			}},
+		},
+	}
+
+	status.SetGatewayClassAccepted(class, false, gatewayv1.GatewayClassReasonInvalidParameters, "parametersRef not found")
+	require.Len(t, class.Status.Conditions, 1)
+	cond := meta.FindStatusCondition(class.Status.Conditions, string(gatewayv1.GatewayClassConditionStatusAccepted))
+	require.NotNil(t, cond)
+	require.Equal(t, metav1.ConditionFalse, cond.Status)
+	require.Equal(t, string(gatewayv1.GatewayClassReasonInvalidParameters), cond.Reason)
+	require.Equal(t, "parametersRef not found", cond.Message)
+	require.Equal(t, int64(2), cond.ObservedGeneration)
+
+	status.SetGatewayClassAccepted(class, true, gatewayv1.GatewayClassReasonAccepted, "")
+	require.Len(t, class.Status.Conditions, 1)
+	cond = meta.FindStatusCondition(class.Status.Conditions, string(gatewayv1.GatewayClassConditionStatusAccepted))
+	require.NotNil(t, cond)
+	require.Equal(t, metav1.ConditionTrue, cond.Status)
+	require.Equal(t, string(gatewayv1.GatewayClassReasonAccepted), cond.Reason)
+}
+
+func TestDetectControllerVersionSkew(t *testing.T) {
+	testCases := []struct {
+		name              string
+		controllerVersion string
+		minVersion        string
+		expected          bool
+	}{{
+		name:              "no recorded version",
+		controllerVersion: "",
+		minVersion:        "v1.2.0",
+		expected:          false,
+	}, {
+		name:              "same version",
+		controllerVersion: "v1.2.0",
+		minVersion:        "v1.2.0",
+		expected:          false,
+	}, {
+		name:              "newer version",
+		controllerVersion: "v1.3.1",
+		minVersion:        "v1.2.0",
+		expected:          false,
+	}, {
+		name:              "older version",
+		controllerVersion: "v1.1.4",
+		minVersion:        "v1.2.0",
+		expected:          true,
+	}, {
+		name:              "unparseable recorded version",
+		controllerVersion: "unknown",
+		minVersion:        "v1.2.0",
+		expected:          true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			class := &gatewayv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "example", Generation: 1},
+				Status:     gatewayv1.GatewayClassStatus{ControllerVersion: tc.controllerVersion},
+			}
+
+			require.Equal(t, tc.expected, status.DetectControllerVersionSkew(class, tc.minVersion))
+
+			cond := meta.FindStatusCondition(class.Status.Conditions, string(gatewayv1.GatewayClassConditionStatusAccepted))
+			if !tc.expected {
+				require.Nil(t, cond)
+				return
+			}
+			require.NotNil(t, cond)
+			require.Equal(t, metav1.ConditionFalse, cond.Status)
+			require.Equal(t, string(gatewayv1.GatewayClassReasonUnsupportedVersion), cond.Reason)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package status provides helpers for implementations writing the status of
+// Gateway API resources.
+package status
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// FilterParentStatusesByController returns the entries of statuses that were
+// written by the provided controller. Controllers can use this to update only
+// their own RouteParentStatus entries while preserving the entries written by
+// other controllers.
+func FilterParentStatusesByController(statuses []gatewayv1.RouteParentStatus, controller gatewayv1.GatewayController) []gatewayv1.RouteParentStatus {
+	var filtered []gatewayv1.RouteParentStatus
+	for _, status := range statuses {
+		if status.ControllerName == controller {
+			filtered = append(filtered, status)
+		}
+	}
+	return filtered
+}
+
+// BackendResolutionCounts holds the number of BackendRefs of a Route that were
+// resolved by a parent, and the total number of BackendRefs considered.
+type BackendResolutionCounts struct {
+	Resolved int32
+	Total    int32
+}
+
+// ComputeResolvedBackendCounts counts, for each ParentRef of the provided
+// HTTPRoute, the BackendRefs that resolved reports as resolved, along with the
+// total number of BackendRefs. The result can be used to populate the
+// ResolvedBackends and TotalBackends fields of RouteParentStatus.
+//
+// The result is aligned with route.Spec.ParentRefs: the counts at index i
+// belong to route.Spec.ParentRefs[i].
+func ComputeResolvedBackendCounts(route *gatewayv1.HTTPRoute, resolved func(parentRef gatewayv1.ParentReference, backendRef gatewayv1.HTTPBackendRef) bool) []BackendResolutionCounts {
+	counts := make([]BackendResolutionCounts, len(route.Spec.ParentRefs))
+	for i, parentRef := range route.Spec.ParentRefs {
+		for _, rule := range route.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				counts[i].Total++
+				if resolved(parentRef, backendRef) {
+					counts[i].Resolved++
+				}
+			}
+		}
+	}
+	return counts
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/status"
+)
+
+func TestFilterParentStatusesByController(t *testing.T) {
+	statuses := []gatewayv1.RouteParentStatus{
+		{ParentRef: gatewayv1.ParentReference{Name: "ingress-a"}, ControllerName: "example.com/ingress"},
+		{ParentRef: gatewayv1.ParentReference{Name: "mesh"}, ControllerName: "example.com/mesh"},
+		{ParentRef: gatewayv1.ParentReference{Name: "ingress-b"}, ControllerName: "example.com/ingress"},
+	}
+
+	testCases := []struct {
+		name       string
+		controller gatewayv1.GatewayController
+		expected   []gatewayv1.RouteParentStatus
+	}{
+		{
+			name:       "multiple matching entries",
+			controller: "example.com/ingress",
+			expected:   []gatewayv1.RouteParentStatus{statuses[0], statuses[2]},
+		},
+		{
+			name:       "single matching entry",
+			controller: "example.com/mesh",
+			expected:   []gatewayv1.RouteParentStatus{statuses[1]},
+		},
+		{
+			name:       "no matching entries",
+			controller: "example.com/other",
+			expected:   nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, status.FilterParentStatusesByController(statuses, tc.controller))
+		})
+	}
+}
+
+func TestComputeResolvedBackendCounts(t *testing.T) {
+	backendRef := func(name gatewayv1.ObjectName) gatewayv1.HTTPBackendRef {
+		return gatewayv1.HTTPBackendRef{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: name}}}
+	}
+
+	route := &gatewayv1.HTTPRoute{
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "internal"}, {Name: "external"}},
+			},
+			Rules: []gatewayv1.HTTPRouteRule{
+				{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("app"), backendRef("missing")}},
+				{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("internal-only")}},
+				{},
+			},
+		},
+	}
+
+	counts := status.ComputeResolvedBackendCounts(route, func(parentRef gatewayv1.ParentReference, backendRef gatewayv1.HTTPBackendRef) bool {
+		switch backendRef.Name {
+		case "app":
+			return true
+		case "internal-only":
+			return parentRef.Name == "internal"
+		default:
+			return false
+		}
+	})
+
+	require.Equal(t, []status.BackendResolutionCounts{
+		{Resolved: 2, Total: 3},
+		{Resolved: 1, Total: 3},
+	}, counts)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// MaxGatewayListeners is the maximum number of listeners a Gateway may have,
+// matching the MaxItems validation on GatewaySpec.Listeners.
+const MaxGatewayListeners = 64
+
+// ValidateGatewayListenerCount checks that the provided Gateway does not have
+// more than MaxGatewayListeners listeners.
+func ValidateGatewayListenerCount(gw *gatewayv1.Gateway) error {
+	if count := len(gw.Spec.Listeners); count > MaxGatewayListeners {
+		return fmt.Errorf("gateway %s/%s has %d listeners, at most %d are allowed", gw.Namespace, gw.Name, count, MaxGatewayListeners)
+	}
+	return nil
+}
+
+// ValidateListenerProtocolTLS checks that the TLS configuration of the
+// provided Listener is consistent with its protocol: the HTTP, TCP and UDP
+// protocols must not specify TLS, and the HTTPS and TLS protocols must.
+// Listeners with implementation-specific protocols are not checked.
+func ValidateListenerProtocolTLS(l gatewayv1.Listener) error {
+	switch l.Protocol {
+	case gatewayv1.HTTPProtocolType, gatewayv1.TCPProtocolType, gatewayv1.UDPProtocolType:
+		if l.TLS != nil {
+			return fmt.Errorf("listener %s: tls must not be specified for protocol %s", l.Name, l.Protocol)
+		}
+	case gatewayv1.HTTPSProtocolType, gatewayv1.TLSProtocolType:
+		if l.TLS == nil {
+			return fmt.Errorf("listener %s: tls must be specified for protocol %s", l.Name, l.Protocol)
+		}
+	}
+	return nil
+}
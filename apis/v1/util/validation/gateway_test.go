@@ -0,0 +1,143 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1/util/validation"
+)
+
+func gatewayWithListeners(count int) *gatewayv1.Gateway {
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+	}
+	for i := 0; i < count; i++ {
+		gw.Spec.Listeners = append(gw.Spec.Listeners, gatewayv1.Listener{
+			Name:     gatewayv1.SectionName(fmt.Sprintf("http-%d", i)),
+			Protocol: gatewayv1.HTTPProtocolType,
+			Port:     gatewayv1.PortNumber(8000 + i),
+		})
+	}
+	return gw
+}
+
+func TestValidateGatewayListenerCount(t *testing.T) {
+	testCases := []struct {
+		name    string
+		gw      *gatewayv1.Gateway
+		isValid bool
+	}{
+		{
+			name:    "single listener",
+			gw:      gatewayWithListeners(1),
+			isValid: true,
+		},
+		{
+			name:    "maximum number of listeners",
+			gw:      gatewayWithListeners(64),
+			isValid: true,
+		},
+		{
+			name:    "too many listeners",
+			gw:      gatewayWithListeners(65),
+			isValid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validationutils.ValidateGatewayListenerCount(tc.gw)
+			if tc.isValid && err != nil {
+				t.Errorf("Expected Gateway to be valid, got error: %v", err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("Expected Gateway to be invalid")
+			}
+		})
+	}
+}
+
+func TestValidateListenerProtocolTLS(t *testing.T) {
+	tlsConfig := &gatewayv1.GatewayTLSConfig{}
+
+	testCases := []struct {
+		name     string
+		protocol gatewayv1.ProtocolType
+		tls      *gatewayv1.GatewayTLSConfig
+		isValid  bool
+	}{
+		{
+			name:     "HTTP without tls",
+			protocol: gatewayv1.HTTPProtocolType,
+			isValid:  true,
+		},
+		{
+			name:     "HTTP with tls",
+			protocol: gatewayv1.HTTPProtocolType,
+			tls:      tlsConfig,
+			isValid:  false,
+		},
+		{
+			name:     "TCP with tls",
+			protocol: gatewayv1.TCPProtocolType,
+			tls:      tlsConfig,
+			isValid:  false,
+		},
+		{
+			name:     "HTTPS with tls",
+			protocol: gatewayv1.HTTPSProtocolType,
+			tls:      tlsConfig,
+			isValid:  true,
+		},
+		{
+			name:     "HTTPS without tls",
+			protocol: gatewayv1.HTTPSProtocolType,
+			isValid:  false,
+		},
+		{
+			name:     "TLS without tls",
+			protocol: gatewayv1.TLSProtocolType,
+			isValid:  false,
+		},
+		{
+			name:     "implementation-specific protocol",
+			protocol: "example.com/custom",
+			tls:      tlsConfig,
+			isValid:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			l := gatewayv1.Listener{Name: "example", Protocol: tc.protocol, Port: 443, TLS: tc.tls}
+			err := validationutils.ValidateListenerProtocolTLS(l)
+			if tc.isValid && err != nil {
+				t.Errorf("Expected Listener to be valid, got error: %v", err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("Expected Listener to be invalid")
+			}
+		})
+	}
+}
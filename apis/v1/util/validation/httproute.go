@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ValidateHTTPRouteWeights checks that when the provided rule has backendRefs,
+// at least one of them has a non-zero weight. An unset weight is treated as
+// the API default of 1.
+func ValidateHTTPRouteWeights(rule gatewayv1.HTTPRouteRule) error {
+	if len(rule.BackendRefs) == 0 {
+		return nil
+	}
+	for _, backendRef := range rule.BackendRefs {
+		if backendRef.Weight == nil || *backendRef.Weight != 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("all %d backendRefs have a weight of 0, at least one backendRef must have a non-zero weight", len(rule.BackendRefs))
+}
+
+// ValidateExtensionRef checks that the provided filter sets ExtensionRef if
+// and only if its Type is ExtensionRef. Implementations that do not recognize
+// the referenced extension should still set the PartiallyInvalid condition
+// with the UnsupportedValue reason on the Route.
+func ValidateExtensionRef(filter gatewayv1.HTTPRouteFilter) error {
+	isExtensionRef := filter.Type == gatewayv1.HTTPRouteFilterExtensionRef
+	if isExtensionRef && filter.ExtensionRef == nil {
+		return fmt.Errorf("extensionRef must be specified for filter type %q", filter.Type)
+	}
+	if !isExtensionRef && filter.ExtensionRef != nil {
+		return fmt.Errorf("extensionRef must not be specified for filter type %q", filter.Type)
+	}
+	return nil
+}
+
+// ValidateHeaderMatchRegex checks that the provided pattern, used as the value
+// of a RegularExpression header match, is a valid RE2 regular expression.
+// Patterns that rely on backtracking features, such as backreferences or
+// lookaround assertions, are rejected. RE2 patterns are matched in time linear
+// in the size of the input, so they cannot cause catastrophic backtracking.
+func ValidateHeaderMatchRegex(pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("header match value %q is not a valid RE2 regular expression: %w", pattern, err)
+	}
+	return nil
+}
+
+// ValidateHTTPRouteRuleCount checks that the provided HTTPRoute does not have
+// more than maxRules rules. The API itself limits HTTPRouteSpec.Rules to 16
+// items; implementations may use this to enforce a lower limit.
+func ValidateHTTPRouteRuleCount(route *gatewayv1.HTTPRoute, maxRules int) error {
+	if count := len(route.Spec.Rules); count > maxRules {
+		return fmt.Errorf("httproute %s/%s has %d rules, at most %d are allowed", route.Namespace, route.Name, count, maxRules)
+	}
+	return nil
+}
+
+// ValidateHTTPRouteUpgradeForListener checks that the upgrade matches of the
+// provided HTTPRoute can be served by the given Listener. An upgrade to HTTP/2
+// (h2c) is only possible on cleartext connections, so it is rejected for
+// listeners with a protocol other than HTTP.
+func ValidateHTTPRouteUpgradeForListener(route *gatewayv1.HTTPRoute, l gatewayv1.Listener) error {
+	if l.Protocol == gatewayv1.HTTPProtocolType {
+		return nil
+	}
+	for i, rule := range route.Spec.Rules {
+		for j, match := range rule.Matches {
+			if match.Upgrade != nil && *match.Upgrade == gatewayv1.HTTPUpgradeHTTP2 {
+				return fmt.Errorf("spec.rules[%d].matches[%d].upgrade: %s is not supported on listener %s with protocol %s", i, j, *match.Upgrade, l.Name, l.Protocol)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1/util/validation"
+)
+
+func ptrTo[T any](a T) *T {
+	return &a
+}
+
+func backendRefWithWeight(name string, weight *int32) gatewayv1.HTTPBackendRef {
+	return gatewayv1.HTTPBackendRef{
+		BackendRef: gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Name: gatewayv1.ObjectName(name),
+				Port: ptrTo(gatewayv1.PortNumber(8080)),
+			},
+			Weight: weight,
+		},
+	}
+}
+
+func TestValidateHTTPRouteWeights(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rule    gatewayv1.HTTPRouteRule
+		isValid bool
+	}{
+		{
+			name:    "no backendRefs",
+			rule:    gatewayv1.HTTPRouteRule{},
+			isValid: true,
+		},
+		{
+			name: "unset weight",
+			rule: gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{backendRefWithWeight("foo", nil)},
+			},
+			isValid: true,
+		},
+		{
+			name: "weighted split with a zero weight",
+			rule: gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					backendRefWithWeight("foo", ptrTo(int32(70))),
+					backendRefWithWeight("bar", ptrTo(int32(30))),
+					backendRefWithWeight("baz", ptrTo(int32(0))),
+				},
+			},
+			isValid: true,
+		},
+		{
+			name: "single zero weight",
+			rule: gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{backendRefWithWeight("foo", ptrTo(int32(0)))},
+			},
+			isValid: false,
+		},
+		{
+			name: "all zero weights",
+			rule: gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					backendRefWithWeight("foo", ptrTo(int32(0))),
+					backendRefWithWeight("bar", ptrTo(int32(0))),
+				},
+			},
+			isValid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validationutils.ValidateHTTPRouteWeights(tc.rule)
+			if tc.isValid && err != nil {
+				t.Errorf("Expected rule to be valid, got error: %v", err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("Expected rule to be invalid")
+			}
+		})
+	}
+}
+
+func TestValidateExtensionRef(t *testing.T) {
+	extensionRef := &gatewayv1.LocalObjectReference{
+		Group: "example.com",
+		Kind:  "Foo",
+		Name:  "foo",
+	}
+
+	testCases := []struct {
+		name    string
+		filter  gatewayv1.HTTPRouteFilter
+		isValid bool
+	}{
+		{
+			name: "extensionRef type with extensionRef",
+			filter: gatewayv1.HTTPRouteFilter{
+				Type:         gatewayv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: extensionRef,
+			},
+			isValid: true,
+		},
+		{
+			name: "extensionRef type without extensionRef",
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterExtensionRef,
+			},
+			isValid: false,
+		},
+		{
+			name: "other type without extensionRef",
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set: []gatewayv1.HTTPHeader{{Name: "foo", Value: "bar"}},
+				},
+			},
+			isValid: true,
+		},
+		{
+			name: "other type with extensionRef",
+			filter: gatewayv1.HTTPRouteFilter{
+				Type:         gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				ExtensionRef: extensionRef,
+			},
+			isValid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validationutils.ValidateExtensionRef(tc.filter)
+			if tc.isValid && err != nil {
+				t.Errorf("Expected filter to be valid, got error: %v", err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("Expected filter to be invalid")
+			}
+		})
+	}
+}
+
+func TestValidateHeaderMatchRegex(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pattern string
+		isValid bool
+	}{
+		{
+			name:    "suffix match",
+			pattern: ".*-beta",
+			isValid: true,
+		},
+		{
+			name:    "anchored alternation",
+			pattern: "^(canary|beta)-[0-9]+$",
+			isValid: true,
+		},
+		{
+			name:    "nested quantifiers are linear in RE2",
+			pattern: "(a+)+$",
+			isValid: true,
+		},
+		{
+			name:    "unbalanced parenthesis",
+			pattern: "(canary",
+			isValid: false,
+		},
+		{
+			name:    "backreference",
+			pattern: `(a)\1`,
+			isValid: false,
+		},
+		{
+			name:    "lookahead",
+			pattern: "canary(?=-beta)",
+			isValid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validationutils.ValidateHeaderMatchRegex(tc.pattern)
+			if tc.isValid && err != nil {
+				t.Errorf("Expected pattern to be valid, got error: %v", err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("Expected pattern to be invalid")
+			}
+		})
+	}
+}
+
+func TestValidateHTTPRouteRuleCount(t *testing.T) {
+	routeWithRules := func(n int) *gatewayv1.HTTPRoute {
+		route := &gatewayv1.HTTPRoute{}
+		route.Namespace = "default"
+		route.Name = "example"
+		route.Spec.Rules = make([]gatewayv1.HTTPRouteRule, n)
+		return route
+	}
+
+	testCases := []struct {
+		name     string
+		route    *gatewayv1.HTTPRoute
+		maxRules int
+		isValid  bool
+	}{
+		{
+			name:     "no rules",
+			route:    routeWithRules(0),
+			maxRules: 16,
+			isValid:  true,
+		},
+		{
+			name:     "rule count at the limit",
+			route:    routeWithRules(16),
+			maxRules: 16,
+			isValid:  true,
+		},
+		{
+			name:     "rule count over an implementation limit",
+			route:    routeWithRules(5),
+			maxRules: 4,
+			isValid:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validationutils.ValidateHTTPRouteRuleCount(tc.route, tc.maxRules)
+			if tc.isValid && err != nil {
+				t.Errorf("Expected HTTPRoute to be valid, got error: %v", err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("Expected HTTPRoute to be invalid")
+			}
+		})
+	}
+}
+
+func TestValidateHTTPRouteUpgradeForListener(t *testing.T) {
+	routeWithUpgrade := func(upgrade gatewayv1.HTTPUpgradeType) *gatewayv1.HTTPRoute {
+		route := &gatewayv1.HTTPRoute{}
+		route.Spec.Rules = []gatewayv1.HTTPRouteRule{{
+			Matches: []gatewayv1.HTTPRouteMatch{{}, {Upgrade: &upgrade}},
+		}}
+		return route
+	}
+
+	testCases := []struct {
+		name     string
+		route    *gatewayv1.HTTPRoute
+		protocol gatewayv1.ProtocolType
+		isValid  bool
+	}{
+		{
+			name:     "no upgrade match on HTTPS listener",
+			route:    &gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{}}}},
+			protocol: gatewayv1.HTTPSProtocolType,
+			isValid:  true,
+		},
+		{
+			name:     "Websocket upgrade on HTTPS listener",
+			route:    routeWithUpgrade(gatewayv1.HTTPUpgradeWebsocket),
+			protocol: gatewayv1.HTTPSProtocolType,
+			isValid:  true,
+		},
+		{
+			name:     "HTTP2 upgrade on HTTP listener",
+			route:    routeWithUpgrade(gatewayv1.HTTPUpgradeHTTP2),
+			protocol: gatewayv1.HTTPProtocolType,
+			isValid:  true,
+		},
+		{
+			name:     "HTTP2 upgrade on HTTPS listener",
+			route:    routeWithUpgrade(gatewayv1.HTTPUpgradeHTTP2),
+			protocol: gatewayv1.HTTPSProtocolType,
+			isValid:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			l := gatewayv1.Listener{Name: "example", Protocol: tc.protocol}
+			err := validationutils.ValidateHTTPRouteUpgradeForListener(tc.route, l)
+			if tc.isValid && err != nil {
+				t.Errorf("Expected HTTPRoute to be valid, got error: %v", err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("Expected HTTPRoute to be invalid")
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/client-go/discovery"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ValidateParametersRefCRD checks that the kind referenced by the provided
+// GatewayClass parametersRef is served by the cluster, using the discovery
+// API. The returned error wraps ErrKindNotServed when the kind is not
+// installed.
+//
+// Implementations can use this to set the "Accepted" condition of the
+// GatewayClass to False with the "InvalidParameters" reason when the
+// referenced CRD is not installed. Other errors, such as the API server being
+// unreachable, are transient and should be retried instead.
+func ValidateParametersRefCRD(ref gatewayv1.ParametersReference, discoveryClient discovery.DiscoveryInterface) error {
+	return validateGroupKindServed(string(ref.Group), string(ref.Kind), discoveryClient)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1/util/validation"
+)
+
+// unreachableDiscovery simulates an API server that cannot be reached.
+type unreachableDiscovery struct {
+	*fakediscovery.FakeDiscovery
+}
+
+func (unreachableDiscovery) ServerGroups() (*metav1.APIGroupList, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestValidateParametersRefCRD(t *testing.T) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "example.com/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "gatewayconfigs", Kind: "GatewayConfig"},
+				},
+			},
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "configmaps", Kind: "ConfigMap"},
+				},
+			},
+		},
+	}}
+
+	testCases := []struct {
+		name      string
+		ref       gatewayv1.ParametersReference
+		isValid   bool
+		notServed bool
+	}{
+		{
+			name:    "installed CRD",
+			ref:     gatewayv1.ParametersReference{Group: "example.com", Kind: "GatewayConfig", Name: "config"},
+			isValid: true,
+		},
+		{
+			name:    "core kind",
+			ref:     gatewayv1.ParametersReference{Group: "", Kind: "ConfigMap", Name: "config"},
+			isValid: true,
+		},
+		{
+			name:      "missing CRD",
+			ref:       gatewayv1.ParametersReference{Group: "example.com", Kind: "ProxyConfig", Name: "config"},
+			notServed: true,
+		},
+		{
+			name:      "missing group",
+			ref:       gatewayv1.ParametersReference{Group: "example.net", Kind: "GatewayConfig", Name: "config"},
+			notServed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validationutils.ValidateParametersRefCRD(tc.ref, discoveryClient)
+			if tc.isValid && err != nil {
+				t.Errorf("expected %v to be valid, got error: %v", tc.ref, err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("expected %v to be invalid", tc.ref)
+			}
+			if tc.notServed != errors.Is(err, validationutils.ErrKindNotServed) {
+				t.Errorf("expected errors.Is(err, ErrKindNotServed) to be %t, got error: %v", tc.notServed, err)
+			}
+		})
+	}
+
+	t.Run("API server unreachable", func(t *testing.T) {
+		ref := gatewayv1.ParametersReference{Group: "example.com", Kind: "GatewayConfig", Name: "config"}
+		err := validationutils.ValidateParametersRefCRD(ref, unreachableDiscovery{discoveryClient})
+		if err == nil {
+			t.Fatalf("expected an error when the API server is unreachable")
+		}
+		if errors.Is(err, validationutils.ErrKindNotServed) {
+			t.Errorf("expected a transient error, got: %v", err)
+		}
+	})
+}
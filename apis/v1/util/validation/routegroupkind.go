@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/client-go/discovery"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ErrKindNotServed is wrapped by the errors returned when a referenced kind
+// is not served by the cluster, as opposed to failures to reach the
+// discovery API.
+var ErrKindNotServed = errors.New("kind is not served by the cluster")
+
+// ValidateRouteGroupKind checks that the API resource referenced by the
+// provided RouteGroupKind is served by the cluster, using the discovery API.
+// An unset group defaults to gateway.networking.k8s.io, matching the default
+// applied by the API server.
+//
+// Implementations can use this to set the "ResolvedRefs" condition to False
+// with the "InvalidRouteKinds" reason on a Listener that allows a Route kind
+// whose CRD is not installed.
+func ValidateRouteGroupKind(kind gatewayv1.RouteGroupKind, discoveryClient discovery.DiscoveryInterface) error {
+	group := gatewayv1.GroupName
+	if kind.Group != nil {
+		group = string(*kind.Group)
+	}
+	return validateGroupKindServed(group, string(kind.Kind), discoveryClient)
+}
+
+func validateGroupKindServed(group, kind string, discoveryClient discovery.DiscoveryInterface) error {
+	groups, err := discoveryClient.ServerGroups()
+	if err != nil {
+		return fmt.Errorf("failed to discover API groups: %w", err)
+	}
+
+	for _, apiGroup := range groups.Groups {
+		if apiGroup.Name != group {
+			continue
+		}
+		for _, version := range apiGroup.Versions {
+			resources, err := discoveryClient.ServerResourcesForGroupVersion(version.GroupVersion)
+			if err != nil {
+				return fmt.Errorf("failed to discover resources for %s: %w", version.GroupVersion, err)
+			}
+			for _, resource := range resources.APIResources {
+				if resource.Kind == kind {
+					return nil
+				}
+			}
+		}
+	}
+
+	if group == "" {
+		return fmt.Errorf("%w: kind %s", ErrKindNotServed, kind)
+	}
+	return fmt.Errorf("%w: kind %s in group %s, is the CRD installed?", ErrKindNotServed, kind, group)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1/util/validation"
+)
+
+func TestValidateRouteGroupKind(t *testing.T) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "gateway.networking.k8s.io/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "gateways", Kind: "Gateway"},
+					{Name: "httproutes", Kind: "HTTPRoute"},
+				},
+			},
+			{
+				GroupVersion: "gateway.networking.k8s.io/v1alpha2",
+				APIResources: []metav1.APIResource{
+					{Name: "tcproutes", Kind: "TCPRoute"},
+				},
+			},
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "services", Kind: "Service"},
+				},
+			},
+		},
+	}}
+
+	group := func(g string) *gatewayv1.Group {
+		gg := gatewayv1.Group(g)
+		return &gg
+	}
+
+	testCases := []struct {
+		name    string
+		kind    gatewayv1.RouteGroupKind
+		isValid bool
+	}{
+		{
+			name:    "default group with installed kind",
+			kind:    gatewayv1.RouteGroupKind{Kind: "HTTPRoute"},
+			isValid: true,
+		},
+		{
+			name:    "explicit group with installed kind",
+			kind:    gatewayv1.RouteGroupKind{Group: group(gatewayv1.GroupName), Kind: "HTTPRoute"},
+			isValid: true,
+		},
+		{
+			name:    "kind served by another version",
+			kind:    gatewayv1.RouteGroupKind{Kind: "TCPRoute"},
+			isValid: true,
+		},
+		{
+			name:    "core group",
+			kind:    gatewayv1.RouteGroupKind{Group: group(""), Kind: "Service"},
+			isValid: true,
+		},
+		{
+			name:    "kind not installed",
+			kind:    gatewayv1.RouteGroupKind{Kind: "GRPCRoute"},
+			isValid: false,
+		},
+		{
+			name:    "group not installed",
+			kind:    gatewayv1.RouteGroupKind{Group: group("example.com"), Kind: "HTTPRoute"},
+			isValid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validationutils.ValidateRouteGroupKind(tc.kind, discoveryClient)
+			if tc.isValid && err != nil {
+				t.Errorf("expected %v to be valid, got error: %v", tc.kind, err)
+			}
+			if !tc.isValid && err == nil {
+				t.Errorf("expected %v to be invalid", tc.kind)
+			}
+		})
+	}
+}
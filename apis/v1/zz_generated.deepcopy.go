@@ -108,6 +108,22 @@ func (in *BackendRef) DeepCopy() *BackendRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendWeightOverride) DeepCopyInto(out *BackendWeightOverride) {
+	*out = *in
+	in.BackendObjectReference.DeepCopyInto(&out.BackendObjectReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendWeightOverride.
+func (in *BackendWeightOverride) DeepCopy() *BackendWeightOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendWeightOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonRouteSpec) DeepCopyInto(out *CommonRouteSpec) {
 	*out = *in
@@ -557,6 +573,41 @@ func (in *GatewayClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassRolloutStatus) DeepCopyInto(out *GatewayClassRolloutStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassRolloutStatus.
+func (in *GatewayClassRolloutStatus) DeepCopy() *GatewayClassRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassRolloutStrategy) DeepCopyInto(out *GatewayClassRolloutStrategy) {
+	*out = *in
+	if in.CanaryWeight != nil {
+		in, out := &in.CanaryWeight, &out.CanaryWeight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassRolloutStrategy.
+func (in *GatewayClassRolloutStrategy) DeepCopy() *GatewayClassRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayClassSpec) DeepCopyInto(out *GatewayClassSpec) {
 	*out = *in
@@ -570,6 +621,11 @@ func (in *GatewayClassSpec) DeepCopyInto(out *GatewayClassSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(GatewayClassRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassSpec.
@@ -597,6 +653,11 @@ func (in *GatewayClassStatus) DeepCopyInto(out *GatewayClassStatus) {
 		*out = make([]SupportedFeature, len(*in))
 		copy(*out, *in)
 	}
+	if in.RolloutStatus != nil {
+		in, out := &in.RolloutStatus, &out.RolloutStatus
+		*out = new(GatewayClassRolloutStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassStatus.
@@ -626,6 +687,13 @@ func (in *GatewayInfrastructure) DeepCopyInto(out *GatewayInfrastructure) {
 			(*out)[key] = val
 		}
 	}
+	if in.ResourceAnnotations != nil {
+		in, out := &in.ResourceAnnotations, &out.ResourceAnnotations
+		*out = make(map[AnnotationKey]AnnotationValue, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.ParametersRef != nil {
 		in, out := &in.ParametersRef, &out.ParametersRef
 		*out = new(LocalParametersReference)
@@ -675,6 +743,21 @@ func (in *GatewayList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayNetworkStatus) DeepCopyInto(out *GatewayNetworkStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayNetworkStatus.
+func (in *GatewayNetworkStatus) DeepCopy() *GatewayNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
 	*out = *in
@@ -733,6 +816,11 @@ func (in *GatewayStatus) DeepCopyInto(out *GatewayStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NetworkStatus != nil {
+		in, out := &in.NetworkStatus, &out.NetworkStatus
+		*out = new(GatewayNetworkStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayStatus.
@@ -827,6 +915,42 @@ func (in *HTTPBackendRef) DeepCopy() *HTTPBackendRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCircuitBreakerFilter) DeepCopyInto(out *HTTPCircuitBreakerFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCircuitBreakerFilter.
+func (in *HTTPCircuitBreakerFilter) DeepCopy() *HTTPCircuitBreakerFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCircuitBreakerFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPGRPCTranscodingFilter) DeepCopyInto(out *HTTPGRPCTranscodingFilter) {
+	*out = *in
+	out.ProtoDescriptorRef = in.ProtoDescriptorRef
+	if in.IgnoreUnknownQueryParameters != nil {
+		in, out := &in.IgnoreUnknownQueryParameters, &out.IgnoreUnknownQueryParameters
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPGRPCTranscodingFilter.
+func (in *HTTPGRPCTranscodingFilter) DeepCopy() *HTTPGRPCTranscodingFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPGRPCTranscodingFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
 	*out = *in
@@ -892,6 +1016,36 @@ func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPJWTAuthFilter) DeepCopyInto(out *HTTPJWTAuthFilter) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HeaderName != nil {
+		in, out := &in.HeaderName, &out.HeaderName
+		*out = new(HTTPHeaderName)
+		**out = **in
+	}
+	if in.ForwardToken != nil {
+		in, out := &in.ForwardToken, &out.ForwardToken
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPJWTAuthFilter.
+func (in *HTTPJWTAuthFilter) DeepCopy() *HTTPJWTAuthFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPJWTAuthFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPPathMatch) DeepCopyInto(out *HTTPPathMatch) {
 	*out = *in
@@ -905,6 +1059,16 @@ func (in *HTTPPathMatch) DeepCopyInto(out *HTTPPathMatch) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NormalizeTrailingSlash != nil {
+		in, out := &in.NormalizeTrailingSlash, &out.NormalizeTrailingSlash
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CaseInsensitive != nil {
+		in, out := &in.CaseInsensitive, &out.CaseInsensitive
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPathMatch.
@@ -962,10 +1126,30 @@ func (in *HTTPQueryParamMatch) DeepCopy() *HTTPQueryParamMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestBodyLimitFilter) DeepCopyInto(out *HTTPRequestBodyLimitFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestBodyLimitFilter.
+func (in *HTTPRequestBodyLimitFilter) DeepCopy() *HTTPRequestBodyLimitFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestBodyLimitFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRequestMirrorFilter) DeepCopyInto(out *HTTPRequestMirrorFilter) {
 	*out = *in
 	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestMirrorFilter.
@@ -1018,6 +1202,76 @@ func (in *HTTPRequestRedirectFilter) DeepCopy() *HTTPRequestRedirectFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPResponseCacheFilter) DeepCopyInto(out *HTTPResponseCacheFilter) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.VaryHeaders != nil {
+		in, out := &in.VaryHeaders, &out.VaryHeaders
+		*out = make([]HTTPHeaderName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateHeaders != nil {
+		in, out := &in.PrivateHeaders, &out.PrivateHeaders
+		*out = make([]HTTPHeaderName, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxResponseBodyBytes != nil {
+		in, out := &in.MaxResponseBodyBytes, &out.MaxResponseBodyBytes
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPResponseCacheFilter.
+func (in *HTTPResponseCacheFilter) DeepCopy() *HTTPResponseCacheFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPResponseCacheFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPResponseCompressionFilter) DeepCopyInto(out *HTTPResponseCompressionFilter) {
+	*out = *in
+	if in.Encodings != nil {
+		in, out := &in.Encodings, &out.Encodings
+		*out = make([]CompressionEncoding, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinSizeBytes != nil {
+		in, out := &in.MinSizeBytes, &out.MinSizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ExcludeContentTypes != nil {
+		in, out := &in.ExcludeContentTypes, &out.ExcludeContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPResponseCompressionFilter.
+func (in *HTTPResponseCompressionFilter) DeepCopy() *HTTPResponseCompressionFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPResponseCompressionFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 	*out = *in
@@ -1045,6 +1299,31 @@ func (in *HTTPRoute) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteFallbackPolicy) DeepCopyInto(out *HTTPRouteFallbackPolicy) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]HTTPRouteFallbackStatusCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteFallbackPolicy.
+func (in *HTTPRouteFallbackPolicy) DeepCopy() *HTTPRouteFallbackPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteFallbackPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRouteFilter) DeepCopyInto(out *HTTPRouteFilter) {
 	*out = *in
@@ -1078,6 +1357,36 @@ func (in *HTTPRouteFilter) DeepCopyInto(out *HTTPRouteFilter) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.RequestBodyLimit != nil {
+		in, out := &in.RequestBodyLimit, &out.RequestBodyLimit
+		*out = new(HTTPRequestBodyLimitFilter)
+		**out = **in
+	}
+	if in.ResponseCompression != nil {
+		in, out := &in.ResponseCompression, &out.ResponseCompression
+		*out = new(HTTPResponseCompressionFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JWTAuth != nil {
+		in, out := &in.JWTAuth, &out.JWTAuth
+		*out = new(HTTPJWTAuthFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(HTTPCircuitBreakerFilter)
+		**out = **in
+	}
+	if in.ResponseCache != nil {
+		in, out := &in.ResponseCache, &out.ResponseCache
+		*out = new(HTTPResponseCacheFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCTranscoding != nil {
+		in, out := &in.GRPCTranscoding, &out.GRPCTranscoding
+		*out = new(HTTPGRPCTranscodingFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteFilter.
@@ -1149,6 +1458,11 @@ func (in *HTTPRouteMatch) DeepCopyInto(out *HTTPRouteMatch) {
 		*out = new(HTTPMethod)
 		**out = **in
 	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(HTTPUpgradeType)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteMatch.
@@ -1164,6 +1478,11 @@ func (in *HTTPRouteMatch) DeepCopy() *HTTPRouteMatch {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRouteRule) DeepCopyInto(out *HTTPRouteRule) {
 	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(SectionName)
+		**out = **in
+	}
 	if in.Matches != nil {
 		in, out := &in.Matches, &out.Matches
 		*out = make([]HTTPRouteMatch, len(*in))
@@ -1195,6 +1514,23 @@ func (in *HTTPRouteRule) DeepCopyInto(out *HTTPRouteRule) {
 		*out = new(SessionPersistence)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FallbackPolicy != nil {
+		in, out := &in.FallbackPolicy, &out.FallbackPolicy
+		*out = new(HTTPRouteFallbackPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendOverrides != nil {
+		in, out := &in.BackendOverrides, &out.BackendOverrides
+		*out = make([]BackendWeightOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AutoWeightAdjustment != nil {
+		in, out := &in.AutoWeightAdjustment, &out.AutoWeightAdjustment
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteRule.
@@ -1216,6 +1552,11 @@ func (in *HTTPRouteSpec) DeepCopyInto(out *HTTPRouteSpec) {
 		*out = make([]Hostname, len(*in))
 		copy(*out, *in)
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]HTTPRouteRule, len(*in))
@@ -1319,6 +1660,11 @@ func (in *Listener) DeepCopyInto(out *Listener) {
 		*out = new(AllowedRoutes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Listener.
@@ -1526,6 +1872,16 @@ func (in *RouteParentStatus) DeepCopyInto(out *RouteParentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResolvedBackends != nil {
+		in, out := &in.ResolvedBackends, &out.ResolvedBackends
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TotalBackends != nil {
+		in, out := &in.TotalBackends, &out.TotalBackends
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteParentStatus.
@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tlsroute provides helpers for implementations processing TLSRoute
+// resources.
+package tlsroute
+
+import (
+	"strings"
+
+	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// ExpandWildcardHostname splits the provided TLSRoute hostname into the value
+// a server name must be compared against. For a wildcard hostname such as
+// `*.example.com`, it returns `.example.com` and true, meaning the server name
+// must end with the returned value and have at least one additional label.
+// For any other hostname, it returns the hostname and false, meaning the
+// server name must be equal to the returned value.
+func ExpandWildcardHostname(hostname gatewayv1a2.Hostname) (prefix string, isSuffix bool) {
+	h := string(hostname)
+	if strings.HasPrefix(h, "*.") {
+		return h[1:], true
+	}
+	return h, false
+}
+
+// MatchesHostname reports whether the provided TLS server name (SNI) matches
+// at least one of the given TLSRoute hostnames. Server names are compared
+// case-insensitively. An empty list of hostnames matches all server names.
+func MatchesHostname(hostnames []gatewayv1a2.Hostname, serverName string) bool {
+	if len(hostnames) == 0 {
+		return true
+	}
+	serverName = strings.ToLower(serverName)
+	for _, hostname := range hostnames {
+		value, isSuffix := ExpandWildcardHostname(hostname)
+		value = strings.ToLower(value)
+		if isSuffix {
+			if len(serverName) > len(value) && strings.HasSuffix(serverName, value) {
+				return true
+			}
+			continue
+		}
+		if serverName == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsroute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2/util/tlsroute"
+)
+
+func TestExpandWildcardHostname(t *testing.T) {
+	prefix, isSuffix := tlsroute.ExpandWildcardHostname("*.example.com")
+	require.Equal(t, ".example.com", prefix)
+	require.True(t, isSuffix)
+
+	prefix, isSuffix = tlsroute.ExpandWildcardHostname("abc.example.com")
+	require.Equal(t, "abc.example.com", prefix)
+	require.False(t, isSuffix)
+}
+
+func TestMatchesHostname(t *testing.T) {
+	testCases := []struct {
+		name       string
+		hostnames  []gatewayv1a2.Hostname
+		serverName string
+		expected   bool
+	}{{
+		name:       "no hostnames",
+		serverName: "abc.example.com",
+		expected:   true,
+	}, {
+		name:       "exact hostname",
+		hostnames:  []gatewayv1a2.Hostname{"abc.example.com"},
+		serverName: "abc.example.com",
+		expected:   true,
+	}, {
+		name:       "exact hostname mismatch",
+		hostnames:  []gatewayv1a2.Hostname{"abc.example.com"},
+		serverName: "a.example.com",
+		expected:   false,
+	}, {
+		name:       "wildcard matches subdomain",
+		hostnames:  []gatewayv1a2.Hostname{"*.example.com"},
+		serverName: "a.example.com",
+		expected:   true,
+	}, {
+		name:       "wildcard matches multiple labels",
+		hostnames:  []gatewayv1a2.Hostname{"*.example.com"},
+		serverName: "a.b.example.com",
+		expected:   true,
+	}, {
+		name:       "wildcard is case insensitive",
+		hostnames:  []gatewayv1a2.Hostname{"*.example.com"},
+		serverName: "B.Example.com",
+		expected:   true,
+	}, {
+		name:       "wildcard does not match parent domain",
+		hostnames:  []gatewayv1a2.Hostname{"*.example.com"},
+		serverName: "example.com",
+		expected:   false,
+	}, {
+		name:       "wildcard does not match other domain",
+		hostnames:  []gatewayv1a2.Hostname{"*.example.com"},
+		serverName: "other.org",
+		expected:   false,
+	}, {
+		name:       "second hostname matches",
+		hostnames:  []gatewayv1a2.Hostname{"abc.example.net", "*.example.com"},
+		serverName: "b.example.com",
+		expected:   true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tlsroute.MatchesHostname(tc.hostnames, tc.serverName))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayListenerMaxConnections)
+}
+
+var GatewayListenerMaxConnections = suite.ConformanceTest{
+	ShortName:   "GatewayListenerMaxConnections",
+	Description: "A Gateway HTTP listener rejects requests with a 503 once its maxConnections limit is reached",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportGatewayListenerMaxConnections,
+	},
+	Manifests: []string{"tests/gateway-listener-max-connections.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		// maxConnections must match the limit configured in the manifest.
+		const maxConnections = 2
+
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "listener-max-connections", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "gateway-listener-max-connections", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		allowed := http.ExpectedResponse{
+			Request:   http.Request{Path: "/"},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, allowed)
+
+		t.Run("requests beyond maxConnections are rejected", func(t *testing.T) {
+			// Hold maxConnections connections open with an incomplete request,
+			// so that the next connection exceeds the limit.
+			for i := 0; i < maxConnections; i++ {
+				conn, err := net.DialTimeout("tcp", gwAddr, 10*time.Second)
+				if err != nil {
+					t.Fatalf("failed to open connection %d to %s: %v", i, gwAddr, err)
+				}
+				defer conn.Close()
+				if _, err := conn.Write([]byte("GET / HTTP/1.1\r\nHost: " + gwAddr + "\r\n")); err != nil {
+					t.Fatalf("failed to write to connection %d: %v", i, err)
+				}
+			}
+
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
+				Request:  http.Request{Path: "/"},
+				Response: http.Response{StatusCode: 503},
+			})
+		})
+
+		t.Run("requests are accepted again once connections are closed", func(t *testing.T) {
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, allowed)
+		})
+	},
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayTLSCertificateConfigMap)
+}
+
+var GatewayTLSCertificateConfigMap = suite.ConformanceTest{
+	ShortName:   "GatewayTLSCertificateConfigMap",
+	Description: "A Gateway HTTPS listener can terminate TLS using a certificate stored in a ConfigMap",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportGatewayTLSCertificateConfigMap,
+	},
+	Manifests: []string{"tests/gateway-tls-certificate-configmap.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "tls-certificate-configmap", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "gateway-tls-certificate-configmap", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		configMap := &v1.ConfigMap{}
+		configMapNN := types.NamespacedName{Name: "tls-configmap-certificate", Namespace: ns}
+		if err := suite.Client.Get(ctx, configMapNN, configMap); err != nil {
+			t.Fatalf("unexpected error finding TLS ConfigMap: %v", err)
+		}
+		cPem := []byte(configMap.Data[v1.TLSCertKey])
+		keyPem := []byte(configMap.Data[v1.TLSPrivateKeyKey])
+
+		expected := http.ExpectedResponse{
+			Request:   http.Request{Host: "example.org", Path: "/"},
+			Response:  http.Response{StatusCode: 200},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}
+		tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, cPem, keyPem, "example.org", expected)
+	},
+}
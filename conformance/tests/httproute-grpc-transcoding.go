@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteGRPCTranscoding)
+}
+
+var HTTPRouteGRPCTranscoding = suite.ConformanceTest{
+	ShortName:   "HTTPRouteGRPCTranscoding",
+	Description: "An HTTPRoute with a GRPCTranscoding filter referencing a descriptor set in a ConfigMap is accepted and transcodes HTTP/JSON requests to gRPC",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRouteGRPCTranscoding,
+	},
+	Manifests: []string{"tests/httproute-grpc-transcoding.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "grpc-transcoding", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		t.Run("HTTP/JSON request should be transcoded to the gRPC backend", func(t *testing.T) {
+			// The GrpcEcho service in conformance/echo-basic/grpcecho.proto
+			// does not declare google.api.http annotations, so there is no
+			// HTTP/JSON mapping to exercise yet. Once the echo service and the
+			// descriptor set in httproute-grpc-transcoding.yaml include those
+			// annotations, this should send a JSON request to the mapped path
+			// and expect the echoed fields from grpc-infra-backend-v1.
+			t.Skip("requires google.api.http annotations on the GrpcEcho service")
+		})
+	},
+}
@@ -87,6 +87,19 @@ var HTTPRouteHeaderMatching = suite.ConformanceTest{
 		}, {
 			Request:  http.Request{Path: "/", Headers: map[string]string{"Color": "purple"}},
 			Response: http.Response{StatusCode: 404},
+		}, {
+			Request:   http.Request{Path: "/", Headers: map[string]string{"Env": "prod", "Tier": "frontend"}},
+			Backend:   "infra-backend-v3",
+			Namespace: ns,
+		}, {
+			Request:  http.Request{Path: "/", Headers: map[string]string{"Env": "prod"}},
+			Response: http.Response{StatusCode: 404},
+		}, {
+			Request:  http.Request{Path: "/", Headers: map[string]string{"Tier": "frontend"}},
+			Response: http.Response{StatusCode: 404},
+		}, {
+			Request:  http.Request{Path: "/", Headers: map[string]string{"Env": "prod", "Tier": "backend"}},
+			Response: http.Response{StatusCode: 404},
 		}}
 
 		for i := range testCases {
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRoutePathCaseInsensitiveMatching)
+}
+
+var HTTPRoutePathCaseInsensitiveMatching = suite.ConformanceTest{
+	ShortName:   "HTTPRoutePathCaseInsensitiveMatching",
+	Description: "A single HTTPRoute with case-insensitive path matching",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRoutePathCaseInsensitiveMatching,
+	},
+	Manifests: []string{"tests/httproute-path-case-insensitive-matching.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "path-case-insensitive-matching", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		testCases := []http.ExpectedResponse{
+			{
+				Request:   http.Request{Path: "/foo/bar"},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			}, {
+				Request:   http.Request{Path: "/Foo/Bar"},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			}, {
+				Request:   http.Request{Path: "/FOO/BAR"},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			}, {
+				Request:   http.Request{Path: "/Foo/baz"},
+				Backend:   "infra-backend-v3",
+				Namespace: ns,
+			}, {
+				Request:   http.Request{Path: "/FOO"},
+				Backend:   "infra-backend-v3",
+				Namespace: ns,
+			}, {
+				// Case-insensitive prefixes still match on path element
+				// boundaries.
+				Request:  http.Request{Path: "/FOObar"},
+				Response: http.Response{StatusCode: 404},
+			}, {
+				Request:   http.Request{Path: "/case-sensitive"},
+				Backend:   "infra-backend-v2",
+				Namespace: ns,
+			}, {
+				Request:  http.Request{Path: "/Case-Sensitive"},
+				Response: http.Response{StatusCode: 404},
+			},
+		}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
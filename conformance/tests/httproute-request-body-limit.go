@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteRequestBodyLimit)
+}
+
+var HTTPRouteRequestBodyLimit = suite.ConformanceTest{
+	ShortName:   "HTTPRouteRequestBodyLimit",
+	Description: "An HTTPRoute with a RequestBodyLimit filter rejects requests with a body larger than the limit",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRouteRequestBodyLimit,
+	},
+	Manifests: []string{"tests/httproute-request-body-limit.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		// maxBytes must match the limit configured in the manifest.
+		const maxBytes = 1024
+
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "request-body-limit", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		testCases := []http.ExpectedResponse{
+			{
+				Request:   http.Request{Method: "POST", Path: "/limited", Body: bytes.Repeat([]byte("a"), maxBytes)},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			}, {
+				Request:  http.Request{Method: "POST", Path: "/limited", Body: bytes.Repeat([]byte("a"), maxBytes+1)},
+				Response: http.Response{StatusCode: 413},
+			}, {
+				Request:   http.Request{Method: "POST", Path: "/unlimited", Body: bytes.Repeat([]byte("a"), maxBytes+1)},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			},
+		}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteRequestPercentageMirror)
+}
+
+var HTTPRouteRequestPercentageMirror = suite.ConformanceTest{
+	ShortName:   "HTTPRouteRequestPercentageMirror",
+	Description: "An HTTPRoute with a request mirror filter that mirrors a percentage of requests",
+	Manifests:   []string{"tests/httproute-request-percentage-mirror.yaml"},
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRouteRequestMirror,
+		features.SupportHTTPRouteRequestPercentageMirror,
+	},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		// The standard deviation of the mirrored fraction is
+		// sqrt(0.2*0.8/totalRequests), about 0.004, so +/-3 points is over 7
+		// standard deviations of sampling noise while still rejecting
+		// implementations mirroring 15% or 25% of requests.
+		const (
+			concurrentRequests  = 10
+			totalRequests       = 10000
+			mirrorPercentage    = 0.2
+			tolerancePercentage = 0.03
+		)
+		var (
+			ns        = "gateway-conformance-infra"
+			routeNN   = types.NamespacedName{Name: "request-percentage-mirror", Namespace: ns}
+			gwNN      = types.NamespacedName{Name: "same-namespace", Namespace: ns}
+			gwAddr    = kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+			mirrorPod = http.BackendRef{Name: "infra-backend-v2", Namespace: ns}
+			expected  = http.ExpectedResponse{
+				Request:   http.Request{Path: "/percentage-mirror"},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			}
+		)
+
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		// Assert request succeeds before sending the mirrored traffic.
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expected)
+
+		before, err := http.CountMirroredRequests(suite.Client, suite.Clientset, mirrorPod, expected.Request.Path)
+		require.NoError(t, err)
+
+		var g errgroup.Group
+		g.SetLimit(concurrentRequests)
+		req := http.MakeRequest(t, &expected, gwAddr, "HTTP", "http")
+		for i := 0; i < totalRequests; i++ {
+			g.Go(func() error {
+				cReq, cRes, err := suite.RoundTripper.CaptureRoundTrip(req)
+				if err != nil {
+					return fmt.Errorf("failed to roundtrip request: %w", err)
+				}
+				return http.CompareRequest(t, &req, cReq, cRes, expected)
+			})
+		}
+		require.NoError(t, g.Wait(), "error while sending requests")
+
+		// Mirrored requests are sent asynchronously, so give the logs some time
+		// to catch up before asserting the mirrored percentage.
+		require.Eventually(t, func() bool {
+			after, err := http.CountMirroredRequests(suite.Client, suite.Clientset, mirrorPod, expected.Request.Path)
+			if err != nil {
+				tlog.Logf(t, "Couldn't count mirrored requests: %v", err)
+				return false
+			}
+			gotPercentage := float64(after-before) / totalRequests
+			tlog.Logf(t, "Mirrored %v of requests, want %v (+/-%v)", gotPercentage, mirrorPercentage, tolerancePercentage)
+			return math.Abs(gotPercentage-mirrorPercentage) <= tolerancePercentage
+		}, 60*time.Second, time.Second, "mirrored traffic not within tolerance")
+	},
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteResponseCache)
+}
+
+var HTTPRouteResponseCache = suite.ConformanceTest{
+	ShortName:   "HTTPRouteResponseCache",
+	Description: "An HTTPRoute with a ResponseCache filter serves cached responses without forwarding requests to the backend",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRouteResponseCache,
+	},
+	Manifests: []string{"tests/httproute-response-cache.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "response-cache", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		// The echo server reflects the request headers in its response, so
+		// the X-Cache-Probe header identifies which request populated the
+		// cache. X-Cache-Probe is not owned or rewritten by proxies, unlike
+		// X-Request-Id, and is not part of the cache key as it is not listed
+		// in varyHeaders.
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
+			Request: http.Request{
+				Path:    "/cached",
+				Headers: map[string]string{"X-Cache-Probe": "first"},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		})
+
+		// A later request must be served from the cache, so the response
+		// still reflects the request that populated it.
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
+			Request: http.Request{
+				Path:    "/cached",
+				Headers: map[string]string{"X-Cache-Probe": "second"},
+			},
+			ExpectedRequest: &http.ExpectedRequest{
+				Request: http.Request{
+					Path:    "/cached",
+					Headers: map[string]string{"X-Cache-Probe": "first"},
+				},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		})
+	},
+}
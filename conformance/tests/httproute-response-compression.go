@@ -0,0 +1,98 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteResponseCompression)
+}
+
+var HTTPRouteResponseCompression = suite.ConformanceTest{
+	ShortName:   "HTTPRouteResponseCompression",
+	Description: "An HTTPRoute with a ResponseCompression filter compresses responses for clients that accept gzip",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRouteResponseCompression,
+	},
+	Manifests: []string{"tests/httproute-response-compression.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "response-compression", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		// Requests always set Accept-Encoding explicitly, since the
+		// transport would otherwise add "gzip" to every request.
+		testCases := []http.ExpectedResponse{
+			{
+				Request: http.Request{
+					Path:    "/compressed",
+					Headers: map[string]string{"Accept-Encoding": "gzip"},
+				},
+				Response: http.Response{
+					Headers: map[string]string{"Content-Encoding": "gzip"},
+				},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			}, {
+				Request: http.Request{
+					Path:    "/compressed",
+					Headers: map[string]string{"Accept-Encoding": "identity"},
+				},
+				Response: http.Response{
+					AbsentHeaders: []string{"Content-Encoding"},
+				},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			}, {
+				// The echoed response is JSON, which this rule excludes
+				// from compression.
+				Request: http.Request{
+					Path:    "/excluded",
+					Headers: map[string]string{"Accept-Encoding": "gzip"},
+				},
+				Response: http.Response{
+					AbsentHeaders: []string{"Content-Encoding"},
+				},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			},
+		}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
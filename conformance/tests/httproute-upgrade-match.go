@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests,
+		HTTPRouteUpgradeMatching,
+	)
+}
+
+var HTTPRouteUpgradeMatching = suite.ConformanceTest{
+	ShortName:   "HTTPRouteUpgradeMatching",
+	Description: "A single HTTPRoute with an upgrade match should only route requests asking for that protocol upgrade",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRouteBackendProtocolWebSocket,
+		features.SupportHTTPRouteUpgradeMatching,
+	},
+	Manifests: []string{
+		"tests/httproute-upgrade-match.yaml",
+	},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "upgrade-match", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		threshold := suite.TimeoutConfig.RequiredConsecutiveSuccesses
+		maxTimeToConsistency := suite.TimeoutConfig.MaxTimeToConsistency
+
+		t.Run("websocket upgrade request should reach the upgrade backend", func(t *testing.T) {
+			http.AwaitConvergence(t, threshold, maxTimeToConsistency, func(_ time.Duration) bool {
+				origin := fmt.Sprintf("ws://gateway/%s", t.Name())
+				remote := fmt.Sprintf("ws://%s/ws", gwAddr)
+
+				ws, err := websocket.Dial(remote, "", origin)
+				if err != nil {
+					t.Log("failed to dial", err)
+					return false
+				}
+				defer ws.Close()
+
+				var (
+					message = "Upgrade matching!"
+					reply   string
+				)
+				if err := websocket.Message.Send(ws, message); err != nil {
+					t.Log("failed to send text frame", err)
+					return false
+				}
+				if err := websocket.Message.Receive(ws, &reply); err != nil {
+					t.Log("failed to receive text frame", err)
+					return false
+				}
+				if message != reply {
+					t.Logf("unexpected reply - want: %s got: %s", message, reply)
+					return false
+				}
+				return true
+			})
+		})
+
+		testCases := []http.ExpectedResponse{
+			{
+				// A request without an upgrade does not match the upgrade rule.
+				Request:  http.Request{Path: "/ws"},
+				Response: http.Response{StatusCode: 404},
+			},
+			{
+				Request:   http.Request{Path: "/plain"},
+				Backend:   "infra-backend-v2",
+				Namespace: ns,
+			},
+		}
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
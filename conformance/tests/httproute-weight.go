@@ -83,7 +83,7 @@ func testDistribution(t *testing.T, suite *suite.ConformanceTestSuite, gwAddr st
 	const (
 		concurrentRequests  = 10
 		tolerancePercentage = 0.05
-		totalRequests       = 500.0
+		totalRequests       = 1000.0
 	)
 	var (
 		roundTripper = suite.RoundTripper
@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TLSRouteWildcardHostname)
+}
+
+var TLSRouteWildcardHostname = suite.ConformanceTest{
+	ShortName:   "TLSRouteWildcardHostname",
+	Description: "A TLSRoute with a wildcard hostname routes all matching subdomains to the same backend",
+	Features: []features.SupportedFeature{
+		features.SupportGateway,
+		features.SupportTLSRoute,
+	},
+	Manifests: []string{"tests/tlsroute-wildcard-hostname.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "tlsroute-wildcard-hostname", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "gateway-tlsroute-wildcard", Namespace: ns}
+		certNN := types.NamespacedName{Name: "tls-passthrough-checks-certificate", Namespace: ns}
+
+		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
+
+		gwAddr, _ := kubernetes.GatewayAndTLSRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		cPem, keyPem, err := GetTLSSecret(suite.Client, certNN)
+		if err != nil {
+			t.Fatalf("unexpected error finding TLS secret: %v", err)
+		}
+
+		for _, serverStr := range []string{"a.example.com", "b.example.com"} {
+			// Declare serverStr here to avoid loop variable reuse issues across parallel tests.
+			serverStr := serverStr
+			t.Run("TLS request for "+serverStr+" should reach tls-backend", func(t *testing.T) {
+				t.Parallel()
+				tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, cPem, keyPem, serverStr,
+					http.ExpectedResponse{
+						Request:   http.Request{Host: serverStr, Path: "/"},
+						Backend:   "tls-backend",
+						Namespace: ns,
+					})
+			})
+		}
+
+		t.Run("TLS request for other.org should not be routed", func(t *testing.T) {
+			t.Parallel()
+			tls.MakeTLSRequestAndExpectEventuallyConsistentFailure(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, cPem, keyPem, "other.org",
+				http.ExpectedResponse{
+					Request: http.Request{Host: "other.org", Path: "/"},
+				})
+		})
+	},
+}
@@ -68,6 +68,7 @@ type Request struct {
 	Method           string
 	Path             string
 	Headers          map[string]string
+	Body             []byte
 	UnfollowRedirect bool
 	Protocol         string
 }
@@ -133,6 +134,7 @@ func MakeRequest(t *testing.T, expected *ExpectedResponse, gwAddr, protocol, sch
 		URL:              reqURL,
 		Protocol:         expected.Request.Protocol,
 		Headers:          map[string][]string{},
+		Body:             expected.Request.Body,
 		UnfollowRedirect: expected.Request.UnfollowRedirect,
 	}
 
@@ -70,3 +70,20 @@ func ExpectMirroredRequest(t *testing.T, client client.Client, clientset clients
 
 	tlog.Log(t, "Found mirrored request log in all desired backends")
 }
+
+// CountMirroredRequests returns the number of requests made to the given path
+// that were logged by the pods backing mirrorPod.
+func CountMirroredRequests(client client.Client, clientset clientset.Interface, mirrorPod BackendRef, path string) (int, error) {
+	mirrorLogRegexp := regexp.MustCompile(fmt.Sprintf("Echoing back request made to \\%s to client", path))
+
+	logs, err := kubernetes.DumpEchoLogs(mirrorPod.Namespace, mirrorPod.Name, client, clientset)
+	if err != nil {
+		return 0, fmt.Errorf(`couldn't read "%s/%s" logs: %w`, mirrorPod.Namespace, mirrorPod.Name, err)
+	}
+
+	count := 0
+	for _, log := range logs {
+		count += len(mirrorLogRegexp.FindAll(log, -1))
+	}
+	return count, nil
+}
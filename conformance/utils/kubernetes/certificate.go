@@ -65,6 +65,30 @@ func MustCreateSelfSignedCertSecret(t *testing.T, namespace, secretName string,
 	return newSecret
 }
 
+// MustCreateSelfSignedCertConfigMap creates a self-signed SSL certificate and stores it in a ConfigMap
+func MustCreateSelfSignedCertConfigMap(t *testing.T, namespace, configMapName string, hosts []string) *corev1.ConfigMap {
+	require.NotEmpty(t, hosts, "require a non-empty hosts for Subject Alternate Name values")
+
+	var serverKey, serverCert bytes.Buffer
+
+	require.NoError(t, generateRSACert(hosts, &serverKey, &serverCert), "failed to generate RSA certificate")
+
+	data := map[string]string{
+		corev1.TLSCertKey:       serverCert.String(),
+		corev1.TLSPrivateKeyKey: serverKey.String(),
+	}
+
+	newConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      configMapName,
+		},
+		Data: data,
+	}
+
+	return newConfigMap
+}
+
 // generateRSACert generates a basic self signed certificate valid for a year
 func generateRSACert(hosts []string, keyOut, certOut io.Writer) error {
 	priv, err := rsa.GenerateKey(rand.Reader, rsaBits)
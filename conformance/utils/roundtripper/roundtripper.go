@@ -17,6 +17,8 @@ limitations under the License.
 package roundtripper
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
@@ -55,6 +57,7 @@ type Request struct {
 	Protocol         string
 	Method           string
 	Headers          map[string][]string
+	Body             []byte
 	UnfollowRedirect bool
 	CertPem          []byte
 	KeyPem           []byte
@@ -193,7 +196,11 @@ func (d *DefaultRoundTripper) defaultRoundTrip(request Request, transport http.R
 	ctx, cancel := context.WithTimeout(context.Background(), d.TimeoutConfig.RequestTimeout)
 	defer cancel()
 	ctx = withT(ctx, request.T)
-	req, err := http.NewRequestWithContext(ctx, method, request.URL.String(), nil)
+	var reqBody io.Reader
+	if len(request.Body) > 0 {
+		reqBody = bytes.NewReader(request.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, request.URL.String(), reqBody)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -241,6 +248,20 @@ func (d *DefaultRoundTripper) defaultRoundTrip(request Request, transport http.R
 		return nil, nil, err
 	}
 
+	// The transport only decompresses responses transparently when it added
+	// the Accept-Encoding header itself. Decompress explicitly requested gzip
+	// responses so that echoed requests can still be parsed.
+	if resp.Header.Get("Content-Encoding") == "gzip" && !resp.Uncompressed {
+		gzipReader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unexpected error decompressing response: %w", err)
+		}
+		body, err = io.ReadAll(gzipReader)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unexpected error decompressing response: %w", err)
+		}
+	}
+
 	// we cannot assume the response is JSON
 	if resp.Header.Get("Content-type") == "application/json" {
 		err = json.Unmarshal(body, cReq)
@@ -63,3 +63,24 @@ func WaitForConsistentTLSResponse(t *testing.T, r roundtripper.RoundTripper, req
 	})
 	tlog.Logf(t, "Request passed")
 }
+
+// MakeTLSRequestAndExpectEventuallyConsistentFailure makes a request with the given parameters,
+// understanding that the request may succeed for some amount of time, and waits until the TLS
+// connection consistently fails. This is used to verify that a server name is not routed.
+func MakeTLSRequestAndExpectEventuallyConsistentFailure(t *testing.T, r roundtripper.RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, cPem, keyPem []byte, server string, expected http.ExpectedResponse) {
+	t.Helper()
+
+	req := http.MakeRequest(t, &expected, gwAddr, "HTTPS", "https")
+	req.KeyPem = keyPem
+	req.CertPem = cPem
+	req.Server = server
+
+	http.AwaitConvergence(t, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig.MaxTimeToConsistency, func(elapsed time.Duration) bool {
+		if _, _, err := r.CaptureRoundTrip(req); err != nil {
+			return true
+		}
+		tlog.Logf(t, "Request succeeded, expected the connection to fail (after %v)", elapsed)
+		return false
+	})
+	tlog.Logf(t, "Request failed as expected")
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client provides helpers for controller-runtime based
+// implementations. The generated clientset, listers and informers live in the
+// subpackages.
+package client
+
+import (
+	"context"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// GatewayClassControllerNameField is the name of the field index on
+// GatewayClass spec.controllerName used by ListGatewayClassesByController.
+const GatewayClassControllerNameField = "spec.controllerName"
+
+// IndexGatewayClassControllerName registers the GatewayClass
+// spec.controllerName field index with the provided indexer, typically the
+// one returned by a controller-runtime Manager's GetFieldIndexer.
+func IndexGatewayClassControllerName(ctx context.Context, indexer crclient.FieldIndexer) error {
+	return indexer.IndexField(ctx, &gatewayv1.GatewayClass{}, GatewayClassControllerNameField, gatewayClassControllerName)
+}
+
+func gatewayClassControllerName(obj crclient.Object) []string {
+	gwc, ok := obj.(*gatewayv1.GatewayClass)
+	if !ok {
+		return nil
+	}
+	return []string{string(gwc.Spec.ControllerName)}
+}
+
+// ListGatewayClassesByController returns the GatewayClasses whose
+// spec.controllerName matches controllerName. The lookup uses the
+// GatewayClassControllerNameField index registered by
+// IndexGatewayClassControllerName. If the index is not available, it falls
+// back to listing all GatewayClasses and filtering them client-side. Any other
+// error from the indexed lookup is returned.
+func ListGatewayClassesByController(ctx context.Context, c crclient.Client, controllerName string) ([]*gatewayv1.GatewayClass, error) {
+	gwcList := &gatewayv1.GatewayClassList{}
+	err := c.List(ctx, gwcList, crclient.MatchingFields{GatewayClassControllerNameField: controllerName})
+	if err == nil {
+		gwcs := make([]*gatewayv1.GatewayClass, 0, len(gwcList.Items))
+		for i := range gwcList.Items {
+			gwcs = append(gwcs, &gwcList.Items[i])
+		}
+		return gwcs, nil
+	}
+	if !isFieldSelectorUnsupported(err) {
+		return nil, err
+	}
+
+	gwcList = &gatewayv1.GatewayClassList{}
+	if err := c.List(ctx, gwcList); err != nil {
+		return nil, err
+	}
+	var gwcs []*gatewayv1.GatewayClass
+	for i := range gwcList.Items {
+		if string(gwcList.Items[i].Spec.ControllerName) == controllerName {
+			gwcs = append(gwcs, &gwcList.Items[i])
+		}
+	}
+	return gwcs, nil
+}
+
+// isFieldSelectorUnsupported reports whether err indicates that a List could
+// not use the requested field selector. controller-runtime caches and fake
+// clients report a missing field index with an untyped error, while the API
+// server rejects unsupported field selectors with a BadRequest.
+func isFieldSelectorUnsupported(err error) bool {
+	if apierrors.IsBadRequest(err) {
+		return strings.Contains(err.Error(), "field label not supported")
+	}
+	return strings.Contains(err.Error(), "index with name")
+}
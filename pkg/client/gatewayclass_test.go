@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func newGatewayClasses(n int) []crclient.Object {
+	objs := make([]crclient.Object, 0, n)
+	for i := 0; i < n; i++ {
+		objs = append(objs, &gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("gwc-%d", i)},
+			Spec: gatewayv1.GatewayClassSpec{
+				ControllerName: gatewayv1.GatewayController(fmt.Sprintf("example.com/controller-%d", i%10)),
+			},
+		})
+	}
+	return objs
+}
+
+func newFakeClient(t testing.TB, indexed bool, objs ...crclient.Object) crclient.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, gatewayv1.AddToScheme(scheme))
+
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...)
+	if indexed {
+		builder = builder.WithIndex(&gatewayv1.GatewayClass{}, GatewayClassControllerNameField, gatewayClassControllerName)
+	}
+	return builder.Build()
+}
+
+func TestListGatewayClassesByController(t *testing.T) {
+	for _, indexed := range []bool{true, false} {
+		t.Run(fmt.Sprintf("indexed=%t", indexed), func(t *testing.T) {
+			c := newFakeClient(t, indexed, newGatewayClasses(20)...)
+
+			gwcs, err := ListGatewayClassesByController(context.Background(), c, "example.com/controller-3")
+			require.NoError(t, err)
+
+			var names []string
+			for _, gwc := range gwcs {
+				names = append(names, gwc.Name)
+			}
+			require.ElementsMatch(t, []string{"gwc-3", "gwc-13"}, names)
+
+			gwcs, err = ListGatewayClassesByController(context.Background(), c, "example.com/unknown")
+			require.NoError(t, err)
+			require.Empty(t, gwcs)
+		})
+	}
+}
+
+func TestListGatewayClassesByControllerError(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, gatewayv1.AddToScheme(scheme))
+
+	var lists int
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		List: func(_ context.Context, _ crclient.WithWatch, _ crclient.ObjectList, _ ...crclient.ListOption) error {
+			lists++
+			return context.Canceled
+		},
+	}).Build()
+
+	_, err := ListGatewayClassesByController(context.Background(), c, "example.com/controller-3")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, lists, "errors other than a missing index must not trigger the fallback")
+}
+
+func benchmarkListGatewayClassesByController(b *testing.B, indexed bool) {
+	c := newFakeClient(b, indexed, newGatewayClasses(10000)...)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ListGatewayClassesByController(ctx, c, "example.com/controller-3"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkListGatewayClassesByControllerIndexed(b *testing.B) {
+	benchmarkListGatewayClassesByController(b, true)
+}
+
+func BenchmarkListGatewayClassesByControllerNotIndexed(b *testing.B) {
+	benchmarkListGatewayClassesByController(b, false)
+}
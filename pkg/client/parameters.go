@@ -0,0 +1,126 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1/util/status"
+)
+
+// ParametersRefValidator validates the object referenced by a GatewayClass
+// parametersRef against the schema expected by an implementation.
+type ParametersRefValidator interface {
+	// ValidateParameters returns a list of human-readable validation
+	// failures for the referenced object. An empty list means the parameters
+	// are valid. An error is returned when validation could not be performed,
+	// for example because the referenced object could not be retrieved.
+	ValidateParameters(ctx context.Context, ref gatewayv1.ParametersReference) ([]string, error)
+}
+
+// ValidateGatewayClassParameters validates the parametersRef of the provided
+// GatewayClass with v when its spec.controllerName matches controllerName. It
+// is intended to be called at startup and on every GatewayClass update.
+//
+// When validation fails, the "Accepted" condition of the GatewayClass is set
+// to False with the "InvalidParameters" reason and the failures are returned.
+// When validation passes, only a stale "Accepted" condition with the
+// "InvalidParameters" reason is replaced, by one set to True with the
+// "Accepted" reason; any other "Accepted" condition, such as one rejecting the
+// GatewayClass for an unsupported version, is left alone.
+//
+// No validation is performed, and the GatewayClass is left unchanged, when it
+// belongs to another controller, has no parametersRef, or v is nil. When
+// validation could not be performed, an error is returned and the conditions
+// are left unchanged. Persisting the updated status is left to the caller.
+func ValidateGatewayClassParameters(ctx context.Context, class *gatewayv1.GatewayClass, controllerName gatewayv1.GatewayController, v ParametersRefValidator) ([]string, error) {
+	if class.Spec.ControllerName != controllerName || class.Spec.ParametersRef == nil || v == nil {
+		return nil, nil
+	}
+
+	failures, err := v.ValidateParameters(ctx, *class.Spec.ParametersRef)
+	if err != nil {
+		return nil, fmt.Errorf("validating parametersRef of GatewayClass %s: %w", class.Name, err)
+	}
+
+	if len(failures) > 0 {
+		status.SetGatewayClassAccepted(class, false, gatewayv1.GatewayClassReasonInvalidParameters,
+			fmt.Sprintf("Invalid parametersRef: %s", strings.Join(failures, "; ")))
+		return failures, nil
+	}
+
+	accepted := meta.FindStatusCondition(class.Status.Conditions, string(gatewayv1.GatewayClassConditionStatusAccepted))
+	if accepted != nil && accepted.Status == metav1.ConditionFalse && accepted.Reason == string(gatewayv1.GatewayClassReasonInvalidParameters) {
+		status.SetGatewayClassAccepted(class, true, gatewayv1.GatewayClassReasonAccepted, "")
+	}
+	return nil, nil
+}
+
+var (
+	parametersValidatorsMu sync.RWMutex
+	parametersValidators   = map[string]ParametersRefValidator{}
+)
+
+// RegisterParametersValidator registers the ParametersRefValidator used by
+// ValidateRegisteredParameters for GatewayClasses whose spec.controllerName
+// matches controllerName, replacing any previously registered validator.
+// Registering a nil validator removes the registration. It is safe for
+// concurrent use.
+func RegisterParametersValidator(controllerName string, v ParametersRefValidator) {
+	parametersValidatorsMu.Lock()
+	defer parametersValidatorsMu.Unlock()
+
+	if v == nil {
+		delete(parametersValidators, controllerName)
+		return
+	}
+	parametersValidators[controllerName] = v
+}
+
+// ValidateRegisteredParameters calls ValidateGatewayClassParameters with the
+// validator registered for the spec.controllerName of the provided
+// GatewayClass. GatewayClasses whose controller has no registered validator
+// are left unchanged.
+func ValidateRegisteredParameters(ctx context.Context, class *gatewayv1.GatewayClass) ([]string, error) {
+	parametersValidatorsMu.RLock()
+	v, ok := parametersValidators[string(class.Spec.ControllerName)]
+	parametersValidatorsMu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+	return ValidateGatewayClassParameters(ctx, class, class.Spec.ControllerName, v)
+}
+
+// DefaultGatewayClassParameters sets the parametersRef of the provided
+// GatewayClass to a copy of defaultRef when the GatewayClass has no
+// parametersRef and its spec.controllerName matches controllerName. It reports
+// whether the GatewayClass was modified. This allows implementations to apply
+// per-installation defaults, for example from a mutating admission webhook.
+func DefaultGatewayClassParameters(class *gatewayv1.GatewayClass, controllerName gatewayv1.GatewayController, defaultRef gatewayv1.ParametersReference) bool {
+	if class.Spec.ControllerName != controllerName || class.Spec.ParametersRef != nil {
+		return false
+	}
+	class.Spec.ParametersRef = defaultRef.DeepCopy()
+	return true
+}
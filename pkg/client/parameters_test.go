@@ -0,0 +1,241 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+type fakeParametersValidator struct {
+	failures []string
+	err      error
+}
+
+func (f fakeParametersValidator) ValidateParameters(_ context.Context, _ gatewayv1.ParametersReference) ([]string, error) {
+	return f.failures, f.err
+}
+
+func TestValidateGatewayClassParameters(t *testing.T) {
+	const controllerName = gatewayv1.GatewayController("example.com/parameters-controller")
+
+	parametersRef := &gatewayv1.ParametersReference{
+		Group: "example.com",
+		Kind:  "Config",
+		Name:  "config",
+	}
+	acceptedCondition := func(status metav1.ConditionStatus, reason gatewayv1.GatewayClassConditionReason) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:   string(gatewayv1.GatewayClassConditionStatusAccepted),
+			Status: status,
+			Reason: string(reason),
+		}}
+	}
+
+	testCases := []struct {
+		name             string
+		controllerName   gatewayv1.GatewayController
+		validator        ParametersRefValidator
+		parametersRef    *gatewayv1.ParametersReference
+		conditions       []metav1.Condition
+		expectedFailures []string
+		expectedErr      bool
+		expectedStatus   metav1.ConditionStatus
+		expectedReason   gatewayv1.GatewayClassConditionReason
+	}{{
+		name:          "no parametersRef",
+		validator:     fakeParametersValidator{failures: []string{"unused"}},
+		parametersRef: nil,
+	}, {
+		name:          "no validator",
+		validator:     nil,
+		parametersRef: parametersRef,
+	}, {
+		name:           "GatewayClass of another controller",
+		controllerName: "example.com/other-controller",
+		validator:      fakeParametersValidator{failures: []string{"unused"}},
+		parametersRef:  parametersRef,
+		conditions:     acceptedCondition(metav1.ConditionFalse, gatewayv1.GatewayClassReasonInvalidParameters),
+		expectedStatus: metav1.ConditionFalse,
+		expectedReason: gatewayv1.GatewayClassReasonInvalidParameters,
+	}, {
+		name:          "valid parameters",
+		validator:     fakeParametersValidator{},
+		parametersRef: parametersRef,
+	}, {
+		name:           "valid parameters clear a previous InvalidParameters result",
+		validator:      fakeParametersValidator{},
+		parametersRef:  parametersRef,
+		conditions:     acceptedCondition(metav1.ConditionFalse, gatewayv1.GatewayClassReasonInvalidParameters),
+		expectedStatus: metav1.ConditionTrue,
+		expectedReason: gatewayv1.GatewayClassReasonAccepted,
+	}, {
+		name:           "valid parameters keep other rejection reasons",
+		validator:      fakeParametersValidator{},
+		parametersRef:  parametersRef,
+		conditions:     acceptedCondition(metav1.ConditionFalse, gatewayv1.GatewayClassReasonUnsupportedVersion),
+		expectedStatus: metav1.ConditionFalse,
+		expectedReason: gatewayv1.GatewayClassReasonUnsupportedVersion,
+	}, {
+		name:             "invalid parameters",
+		validator:        fakeParametersValidator{failures: []string{"spec.replicas: must be positive", "spec.mode: unknown value"}},
+		parametersRef:    parametersRef,
+		expectedFailures: []string{"spec.replicas: must be positive", "spec.mode: unknown value"},
+		expectedStatus:   metav1.ConditionFalse,
+		expectedReason:   gatewayv1.GatewayClassReasonInvalidParameters,
+	}, {
+		name:          "validation error",
+		validator:     fakeParametersValidator{err: errors.New("not found")},
+		parametersRef: parametersRef,
+		expectedErr:   true,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &gatewayv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "gwc", Generation: 2},
+				Spec: gatewayv1.GatewayClassSpec{
+					ControllerName: controllerName,
+					ParametersRef:  tc.parametersRef,
+				},
+				Status: gatewayv1.GatewayClassStatus{
+					Conditions: tc.conditions,
+				},
+			}
+			if tc.controllerName != "" {
+				class.Spec.ControllerName = tc.controllerName
+			}
+
+			failures, err := ValidateGatewayClassParameters(context.Background(), class, controllerName, tc.validator)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedFailures, failures)
+
+			accepted := meta.FindStatusCondition(class.Status.Conditions, string(gatewayv1.GatewayClassConditionStatusAccepted))
+			if tc.expectedStatus == "" {
+				require.Nil(t, accepted)
+				return
+			}
+			require.NotNil(t, accepted)
+			require.Equal(t, tc.expectedStatus, accepted.Status)
+			require.Equal(t, string(tc.expectedReason), accepted.Reason)
+			if len(tc.expectedFailures) > 0 {
+				require.Equal(t, int64(2), accepted.ObservedGeneration)
+				require.Contains(t, accepted.Message, "spec.replicas: must be positive")
+			}
+		})
+	}
+}
+
+func TestValidateRegisteredParameters(t *testing.T) {
+	const controllerName = "example.com/registered-controller"
+
+	newClass := func(controllerName gatewayv1.GatewayController) *gatewayv1.GatewayClass {
+		return &gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "gwc"},
+			Spec: gatewayv1.GatewayClassSpec{
+				ControllerName: controllerName,
+				ParametersRef:  &gatewayv1.ParametersReference{Group: "example.com", Kind: "Config", Name: "config"},
+			},
+		}
+	}
+
+	RegisterParametersValidator(controllerName, fakeParametersValidator{failures: []string{"spec.mode: unknown value"}})
+	t.Cleanup(func() { RegisterParametersValidator(controllerName, nil) })
+
+	class := newClass(controllerName)
+	failures, err := ValidateRegisteredParameters(context.Background(), class)
+	require.NoError(t, err)
+	require.Equal(t, []string{"spec.mode: unknown value"}, failures)
+	require.True(t, meta.IsStatusConditionFalse(class.Status.Conditions, string(gatewayv1.GatewayClassConditionStatusAccepted)))
+
+	class = newClass("example.com/unregistered-controller")
+	failures, err = ValidateRegisteredParameters(context.Background(), class)
+	require.NoError(t, err)
+	require.Empty(t, failures)
+	require.Empty(t, class.Status.Conditions)
+
+	RegisterParametersValidator(controllerName, nil)
+	class = newClass(controllerName)
+	failures, err = ValidateRegisteredParameters(context.Background(), class)
+	require.NoError(t, err)
+	require.Empty(t, failures)
+	require.Empty(t, class.Status.Conditions)
+}
+
+func TestDefaultGatewayClassParameters(t *testing.T) {
+	const controllerName = gatewayv1.GatewayController("example.com/parameters-controller")
+
+	namespace := gatewayv1.Namespace("gateway-system")
+	defaultRef := gatewayv1.ParametersReference{
+		Group:     "",
+		Kind:      "ConfigMap",
+		Name:      "gateway-defaults",
+		Namespace: &namespace,
+	}
+	existingRef := &gatewayv1.ParametersReference{
+		Group: "example.com",
+		Kind:  "Config",
+		Name:  "config",
+	}
+
+	testCases := []struct {
+		name          string
+		controller    gatewayv1.GatewayController
+		parametersRef *gatewayv1.ParametersReference
+		expectedRef   *gatewayv1.ParametersReference
+		expected      bool
+	}{{
+		name:        "defaults missing parametersRef",
+		controller:  controllerName,
+		expectedRef: &defaultRef,
+		expected:    true,
+	}, {
+		name:          "keeps existing parametersRef",
+		controller:    controllerName,
+		parametersRef: existingRef,
+		expectedRef:   existingRef,
+	}, {
+		name:       "ignores other controllers",
+		controller: "example.com/other-controller",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &gatewayv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				Spec: gatewayv1.GatewayClassSpec{
+					ControllerName: tc.controller,
+					ParametersRef:  tc.parametersRef,
+				},
+			}
+
+			require.Equal(t, tc.expected, DefaultGatewayClassParameters(class, controllerName, defaultRef))
+			require.Equal(t, tc.expectedRef, class.Spec.ParametersRef)
+		})
+	}
+}
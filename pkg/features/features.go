@@ -58,6 +58,14 @@ const (
 	// SupportGatewayHTTPListenerIsolation option indicates support for the isolation
 	// of HTTP listeners.
 	SupportGatewayHTTPListenerIsolation SupportedFeature = "GatewayHTTPListenerIsolation"
+
+	// SupportGatewayTLSCertificateConfigMap option indicates support for
+	// listener TLS certificates stored in a ConfigMap.
+	SupportGatewayTLSCertificateConfigMap SupportedFeature = "GatewayTLSCertificateConfigMap"
+
+	// SupportGatewayListenerMaxConnections option indicates support for
+	// limiting the number of concurrent connections per listener.
+	SupportGatewayListenerMaxConnections SupportedFeature = "GatewayListenerMaxConnections"
 )
 
 // GatewayExtendedFeatures are extra generic features that implementations may
@@ -66,6 +74,8 @@ var GatewayExtendedFeatures = sets.New(
 	SupportGatewayPort8080,
 	SupportGatewayStaticAddresses,
 	SupportGatewayHTTPListenerIsolation,
+	SupportGatewayTLSCertificateConfigMap,
+	SupportGatewayListenerMaxConnections,
 )
 
 // -----------------------------------------------------------------------------
@@ -136,6 +146,9 @@ const (
 	// This option indicates support for multiple RequestMirror filters within the same HTTPRoute rule (extended conformance).
 	SupportHTTPRouteRequestMultipleMirrors SupportedFeature = "HTTPRouteRequestMultipleMirrors"
 
+	// This option indicates support for HTTPRoute request mirror of a percentage of requests (extended conformance).
+	SupportHTTPRouteRequestPercentageMirror SupportedFeature = "HTTPRouteRequestPercentageMirror"
+
 	// This option indicates support for HTTPRoute request timeouts (extended conformance).
 	SupportHTTPRouteRequestTimeout SupportedFeature = "HTTPRouteRequestTimeout"
 
@@ -150,6 +163,33 @@ const (
 
 	// This option indicates support for HTTPRoute with a backendref with an appProtoocol 'kubernetes.io/ws' (extended conformance)
 	SupportHTTPRouteBackendProtocolWebSocket SupportedFeature = "HTTPRouteBackendProtocolWebSocket"
+
+	// This option indicates support for HTTPRoute case-insensitive path matching (extended conformance)
+	SupportHTTPRoutePathCaseInsensitiveMatching SupportedFeature = "HTTPRoutePathCaseInsensitiveMatching"
+
+	// This option indicates support for HTTPRoute backend fallback on server errors (extended conformance)
+	SupportHTTPRouteFallbackPolicy SupportedFeature = "HTTPRouteFallbackPolicy"
+
+	// This option indicates support for HTTPRoute request body size limits (extended conformance)
+	SupportHTTPRouteRequestBodyLimit SupportedFeature = "HTTPRouteRequestBodyLimit"
+
+	// This option indicates support for HTTPRoute response compression (extended conformance)
+	SupportHTTPRouteResponseCompression SupportedFeature = "HTTPRouteResponseCompression"
+
+	// This option indicates support for HTTPRoute JWT authentication (extended conformance)
+	SupportHTTPRouteJWTAuth SupportedFeature = "HTTPRouteJWTAuth"
+
+	// This option indicates support for HTTPRoute backend circuit breaking (extended conformance)
+	SupportHTTPRouteCircuitBreaker SupportedFeature = "HTTPRouteCircuitBreaker"
+
+	// This option indicates support for HTTPRoute response caching (extended conformance)
+	SupportHTTPRouteResponseCache SupportedFeature = "HTTPRouteResponseCache"
+
+	// This option indicates support for HTTPRoute protocol upgrade matching (extended conformance)
+	SupportHTTPRouteUpgradeMatching SupportedFeature = "HTTPRouteUpgradeMatching"
+
+	// This option indicates support for HTTPRoute HTTP/JSON to gRPC transcoding (extended conformance)
+	SupportHTTPRouteGRPCTranscoding SupportedFeature = "HTTPRouteGRPCTranscoding"
 )
 
 // HTTPRouteExtendedFeatures includes all extended features for HTTPRoute
@@ -166,12 +206,22 @@ var HTTPRouteExtendedFeatures = sets.New(
 	SupportHTTPRoutePathRewrite,
 	SupportHTTPRouteRequestMirror,
 	SupportHTTPRouteRequestMultipleMirrors,
+	SupportHTTPRouteRequestPercentageMirror,
 	SupportHTTPRouteRequestTimeout,
 	SupportHTTPRouteBackendTimeout,
 	SupportHTTPRouteParentRefPort,
 	SupportHTTPRouteBackendRequestHeaderModification,
 	SupportHTTPRouteBackendProtocolH2C,
 	SupportHTTPRouteBackendProtocolWebSocket,
+	SupportHTTPRoutePathCaseInsensitiveMatching,
+	SupportHTTPRouteFallbackPolicy,
+	SupportHTTPRouteRequestBodyLimit,
+	SupportHTTPRouteResponseCompression,
+	SupportHTTPRouteJWTAuth,
+	SupportHTTPRouteCircuitBreaker,
+	SupportHTTPRouteResponseCache,
+	SupportHTTPRouteUpgradeMatching,
+	SupportHTTPRouteGRPCTranscoding,
 )
 
 // -----------------------------------------------------------------------------
@@ -84,6 +84,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/gateway-api/apis/v1.AllowedRoutes":                                   schema_sigsk8sio_gateway_api_apis_v1_AllowedRoutes(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.BackendObjectReference":                          schema_sigsk8sio_gateway_api_apis_v1_BackendObjectReference(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.BackendRef":                                      schema_sigsk8sio_gateway_api_apis_v1_BackendRef(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.BackendWeightOverride":                           schema_sigsk8sio_gateway_api_apis_v1_BackendWeightOverride(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.CommonRouteSpec":                                 schema_sigsk8sio_gateway_api_apis_v1_CommonRouteSpec(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.CookieConfig":                                    schema_sigsk8sio_gateway_api_apis_v1_CookieConfig(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.FrontendTLSValidation":                           schema_sigsk8sio_gateway_api_apis_v1_FrontendTLSValidation(ref),
@@ -101,24 +102,34 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayAddress":                                  schema_sigsk8sio_gateway_api_apis_v1_GatewayAddress(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayClass":                                    schema_sigsk8sio_gateway_api_apis_v1_GatewayClass(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayClassList":                                schema_sigsk8sio_gateway_api_apis_v1_GatewayClassList(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.GatewayClassRolloutStatus":                       schema_sigsk8sio_gateway_api_apis_v1_GatewayClassRolloutStatus(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.GatewayClassRolloutStrategy":                     schema_sigsk8sio_gateway_api_apis_v1_GatewayClassRolloutStrategy(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayClassSpec":                                schema_sigsk8sio_gateway_api_apis_v1_GatewayClassSpec(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayClassStatus":                              schema_sigsk8sio_gateway_api_apis_v1_GatewayClassStatus(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayInfrastructure":                           schema_sigsk8sio_gateway_api_apis_v1_GatewayInfrastructure(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayList":                                     schema_sigsk8sio_gateway_api_apis_v1_GatewayList(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.GatewayNetworkStatus":                            schema_sigsk8sio_gateway_api_apis_v1_GatewayNetworkStatus(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewaySpec":                                     schema_sigsk8sio_gateway_api_apis_v1_GatewaySpec(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayStatus":                                   schema_sigsk8sio_gateway_api_apis_v1_GatewayStatus(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayStatusAddress":                            schema_sigsk8sio_gateway_api_apis_v1_GatewayStatusAddress(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.GatewayTLSConfig":                                schema_sigsk8sio_gateway_api_apis_v1_GatewayTLSConfig(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPBackendRef":                                  schema_sigsk8sio_gateway_api_apis_v1_HTTPBackendRef(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.HTTPCircuitBreakerFilter":                        schema_sigsk8sio_gateway_api_apis_v1_HTTPCircuitBreakerFilter(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.HTTPGRPCTranscodingFilter":                       schema_sigsk8sio_gateway_api_apis_v1_HTTPGRPCTranscodingFilter(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPHeader":                                      schema_sigsk8sio_gateway_api_apis_v1_HTTPHeader(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPHeaderFilter":                                schema_sigsk8sio_gateway_api_apis_v1_HTTPHeaderFilter(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPHeaderMatch":                                 schema_sigsk8sio_gateway_api_apis_v1_HTTPHeaderMatch(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.HTTPJWTAuthFilter":                               schema_sigsk8sio_gateway_api_apis_v1_HTTPJWTAuthFilter(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPPathMatch":                                   schema_sigsk8sio_gateway_api_apis_v1_HTTPPathMatch(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPPathModifier":                                schema_sigsk8sio_gateway_api_apis_v1_HTTPPathModifier(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPQueryParamMatch":                             schema_sigsk8sio_gateway_api_apis_v1_HTTPQueryParamMatch(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.HTTPRequestBodyLimitFilter":                      schema_sigsk8sio_gateway_api_apis_v1_HTTPRequestBodyLimitFilter(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPRequestMirrorFilter":                         schema_sigsk8sio_gateway_api_apis_v1_HTTPRequestMirrorFilter(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPRequestRedirectFilter":                       schema_sigsk8sio_gateway_api_apis_v1_HTTPRequestRedirectFilter(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.HTTPResponseCacheFilter":                         schema_sigsk8sio_gateway_api_apis_v1_HTTPResponseCacheFilter(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.HTTPResponseCompressionFilter":                   schema_sigsk8sio_gateway_api_apis_v1_HTTPResponseCompressionFilter(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPRoute":                                       schema_sigsk8sio_gateway_api_apis_v1_HTTPRoute(ref),
+		"sigs.k8s.io/gateway-api/apis/v1.HTTPRouteFallbackPolicy":                         schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteFallbackPolicy(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPRouteFilter":                                 schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteFilter(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPRouteList":                                   schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteList(ref),
 		"sigs.k8s.io/gateway-api/apis/v1.HTTPRouteMatch":                                  schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteMatch(ref),
@@ -2733,13 +2744,13 @@ func schema_sigsk8sio_gateway_api_apis_v1_AllowedRoutes(ref common.ReferenceCall
 				Properties: map[string]spec.Schema{
 					"namespaces": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespaces indicates namespaces from which Routes may be attached to this Listener. This is restricted to the namespace of this Gateway by default.\n\nSupport: Core",
+							Description: "Namespaces indicates namespaces from which Routes may be attached to this Listener. This is restricted to the namespace of this Gateway by default.\n\n<gateway:experimental:description> When From is set to \"Selector\", the selector must not be empty. An empty selector matches all namespaces, which should instead be expressed with From set to \"All\". </gateway:experimental:description>\n\nSupport: Core\n\n<gateway:experimental:validation:XValidation:message=\"selector must not be empty when from is Selector, use from All to select all namespaces\",rule=\"!has(self.from) || self.from != 'Selector' || (has(self.selector) && ((has(self.selector.matchLabels) && size(self.selector.matchLabels) > 0) || (has(self.selector.matchExpressions) && size(self.selector.matchExpressions) > 0)))\">",
 							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.RouteNamespaces"),
 						},
 					},
 					"kinds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kinds specifies the groups and kinds of Routes that are allowed to bind to this Gateway Listener. When unspecified or empty, the kinds of Routes selected are determined using the Listener protocol.\n\nA RouteGroupKind MUST correspond to kinds of Routes that are compatible with the application protocol specified in the Listener's Protocol field. If an implementation does not support or recognize this resource type, it MUST set the \"ResolvedRefs\" condition to False for this Listener with the \"InvalidRouteKinds\" reason.\n\nSupport: Core",
+							Description: "Kinds specifies the groups and kinds of Routes that are allowed to bind to this Gateway Listener. When unspecified or empty, the kinds of Routes selected are determined using the Listener protocol.\n\n<gateway:experimental:description> The kinds selected for each protocol when Kinds is unspecified or empty are:\n\n* HTTP and HTTPS: HTTPRoute and GRPCRoute * TLS: TLSRoute (Passthrough mode) and TCPRoute (Terminate mode) * TCP: TCPRoute * UDP: UDPRoute\n\nImplementations only select the kinds from this list that they support. </gateway:experimental:description>\n\nA RouteGroupKind MUST correspond to kinds of Routes that are compatible with the application protocol specified in the Listener's Protocol field. If an implementation does not support or recognize this resource type, it MUST set the \"ResolvedRefs\" condition to False for this Listener with the \"InvalidRouteKinds\" reason.\n\nSupport: Core",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2866,6 +2877,64 @@ func schema_sigsk8sio_gateway_api_apis_v1_BackendRef(ref common.ReferenceCallbac
 	}
 }
 
+func schema_sigsk8sio_gateway_api_apis_v1_BackendWeightOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackendWeightOverride overrides the weight of the BackendRefs referencing the given backend within an HTTPRouteRule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the group of the referent. For example, \"gateway.networking.k8s.io\". When unspecified or empty string, core API group is inferred.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the Kubernetes resource kind of the referent. For example \"Service\".\n\nDefaults to \"Service\" when not specified.\n\nExternalName services can refer to CNAME DNS records that may live outside of the cluster and as such are difficult to reason about in terms of conformance. They also may not be safe to forward to (see CVE-2021-25740 for more information). Implementations SHOULD NOT support ExternalName Services.\n\nSupport: Core (Services with a type other than ExternalName)\n\nSupport: Implementation-specific (Services with type ExternalName)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the referent.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the backend. When unspecified, the local namespace is inferred.\n\nNote that when a namespace different than the local namespace is specified, a ReferenceGrant object is required in the referent namespace to allow that namespace's owner to accept the reference. See the ReferenceGrant documentation for details.\n\nSupport: Core",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port specifies the destination port number to use for this resource. Port is required when the referent is a Kubernetes Service. In this case, the port number is the service port number, not the target port. For other resources, destination port might be derived from the referent resource or this field.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight replaces the weight of the matching BackendRefs. The same semantics as the BackendRef weight apply, so a weight of 0 stops traffic from being forwarded to the matching BackendRefs.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "weight"},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_gateway_api_apis_v1_CommonRouteSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3606,6 +3675,57 @@ func schema_sigsk8sio_gateway_api_apis_v1_GatewayClassList(ref common.ReferenceC
 	}
 }
 
+func schema_sigsk8sio_gateway_api_apis_v1_GatewayClassRolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GatewayClassRolloutStatus describes the progress of a GatewayClass rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_gateway_api_apis_v1_GatewayClassRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GatewayClassRolloutStrategy defines how changes to the data plane of Gateways of a GatewayClass are rolled out.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of rollout strategy.\n\nSupport: Extended",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"canaryWeight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanaryWeight is the percentage of traffic shifted to the new data plane while a BlueGreen rollout is in the \"CanaryActive\" phase. When unspecified, the amount of traffic shifted and how it progresses is implementation-specific.\n\nSupport: Extended",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_gateway_api_apis_v1_GatewayClassSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3634,12 +3754,18 @@ func schema_sigsk8sio_gateway_api_apis_v1_GatewayClassSpec(ref common.ReferenceC
 							Format:      "",
 						},
 					},
+					"rolloutStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStrategy defines how the controller rolls out changes to the data plane of Gateways of this class, such as a controller version upgrade. When unspecified, the rollout behavior is implementation-specific.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.GatewayClassRolloutStrategy"),
+						},
+					},
 				},
 				Required: []string{"controllerName"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/gateway-api/apis/v1.ParametersReference"},
+			"sigs.k8s.io/gateway-api/apis/v1.GatewayClassRolloutStrategy", "sigs.k8s.io/gateway-api/apis/v1.ParametersReference"},
 	}
 }
 
@@ -3692,11 +3818,24 @@ func schema_sigsk8sio_gateway_api_apis_v1_GatewayClassStatus(ref common.Referenc
 							},
 						},
 					},
+					"controllerVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControllerVersion is the version of the controller that last reconciled this GatewayClass, for example \"v1.2.0\". Controllers SHOULD set this field on every reconcile. A controller that finds a version it can no longer support SHOULD set the \"Accepted\" condition to false with the \"UnsupportedVersion\" reason. The format of the version is implementation-specific, but semantic versions are recommended.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rolloutStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStatus describes the progress of the most recent rollout performed according to the RolloutStrategy of this GatewayClass.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.GatewayClassRolloutStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/gateway-api/apis/v1.GatewayClassRolloutStatus"},
 	}
 }
 
@@ -3739,6 +3878,22 @@ func schema_sigsk8sio_gateway_api_apis_v1_GatewayInfrastructure(ref common.Refer
 							},
 						},
 					},
+					"resourceAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceAnnotations are annotations that SHOULD be applied to infrastructure provisioned outside of Kubernetes in response to this Gateway, such as cloud load balancers. Unlike Annotations, these are not intended to be copied onto generated Kubernetes objects, which prevents cloud controllers from confusing them with unrelated annotations.\n\nKeys must be valid Kubernetes annotation keys: an optional DNS subdomain prefix followed by a \"/\" and a name.\n\nWhen a key is present in both Annotations and ResourceAnnotations, the value from ResourceAnnotations takes precedence for provisioned infrastructure.\n\nSupport: Extended",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"parametersRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ParametersRef is a reference to a resource that contains the configuration parameters corresponding to the Gateway. This is optional if the controller does not require any additional configuration.\n\nThis follows the same semantics as GatewayClass's `parametersRef`, but on a per-Gateway basis\n\nThe Gateway's GatewayClass may provide its own `parametersRef`. When both are specified, the merging behavior is implementation specific. It is generally recommended that GatewayClass provides defaults that can be overridden by a Gateway.\n\nSupport: Implementation-specific",
@@ -3802,6 +3957,40 @@ func schema_sigsk8sio_gateway_api_apis_v1_GatewayList(ref common.ReferenceCallba
 	}
 }
 
+func schema_sigsk8sio_gateway_api_apis_v1_GatewayNetworkStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GatewayNetworkStatus describes the network infrastructure provisioned for a Gateway. All fields are implementation-specific and opaque to Gateway API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cloudResourceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudResourceID is the identifier of the provisioned cloud resource, for example the ARN or resource path of a load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the cloud region in which the network infrastructure was provisioned.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the cloud zone in which the network infrastructure was provisioned, if it is zonal.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_gateway_api_apis_v1_GatewaySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3827,7 +4016,7 @@ func schema_sigsk8sio_gateway_api_apis_v1_GatewaySpec(ref common.ReferenceCallba
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Listeners associated with this Gateway. Listeners define logical endpoints that are bound on this Gateway's addresses. At least one Listener MUST be specified.\n\nEach Listener in a set of Listeners (for example, in a single Gateway) MUST be _distinct_, in that a traffic flow MUST be able to be assigned to exactly one listener. (This section uses \"set of Listeners\" rather than \"Listeners in a single Gateway\" because implementations MAY merge configuration from multiple Gateways onto a single data plane, and these rules _also_ apply in that case).\n\nPractically, this means that each listener in a set MUST have a unique combination of Port, Protocol, and, if supported by the protocol, Hostname.\n\nSome combinations of port, protocol, and TLS settings are considered Core support and MUST be supported by implementations based on their targeted conformance profile:\n\nHTTP Profile\n\n1. HTTPRoute, Port: 80, Protocol: HTTP 2. HTTPRoute, Port: 443, Protocol: HTTPS, TLS Mode: Terminate, TLS keypair provided\n\nTLS Profile\n\n1. TLSRoute, Port: 443, Protocol: TLS, TLS Mode: Passthrough\n\n\"Distinct\" Listeners have the following property:\n\nThe implementation can match inbound requests to a single distinct Listener. When multiple Listeners share values for fields (for example, two Listeners with the same Port value), the implementation can match requests to only one of the Listeners using other Listener fields.\n\nFor example, the following Listener scenarios are distinct:\n\n1. Multiple Listeners with the same Port that all use the \"HTTP\"\n   Protocol that all have unique Hostname values.\n2. Multiple Listeners with the same Port that use either the \"HTTPS\" or\n   \"TLS\" Protocol that all have unique Hostname values.\n3. A mixture of \"TCP\" and \"UDP\" Protocol Listeners, where no Listener\n   with the same Protocol has the same Port value.\n\nSome fields in the Listener struct have possible values that affect whether the Listener is distinct. Hostname is particularly relevant for HTTP or HTTPS protocols.\n\nWhen using the Hostname value to select between same-Port, same-Protocol Listeners, the Hostname value must be different on each Listener for the Listener to be distinct.\n\nWhen the Listeners are distinct based on Hostname, inbound request hostnames MUST match from the most specific to least specific Hostname values to choose the correct Listener and its associated set of Routes.\n\nExact matches must be processed before wildcard matches, and wildcard matches must be processed before fallback (empty Hostname value) matches. For example, `\"foo.example.com\"` takes precedence over `\"*.example.com\"`, and `\"*.example.com\"` takes precedence over `\"\"`.\n\nAdditionally, if there are multiple wildcard entries, more specific wildcard entries must be processed before less specific wildcard entries. For example, `\"*.foo.example.com\"` takes precedence over `\"*.example.com\"`. The precise definition here is that the higher the number of dots in the hostname to the right of the wildcard character, the higher the precedence.\n\nThe wildcard character will match any number of characters _and dots_ to the left, however, so `\"*.example.com\"` will match both `\"foo.bar.example.com\"` _and_ `\"bar.example.com\"`.\n\nIf a set of Listeners contains Listeners that are not distinct, then those Listeners are Conflicted, and the implementation MUST set the \"Conflicted\" condition in the Listener Status to \"True\".\n\nImplementations MAY choose to accept a Gateway with some Conflicted Listeners only if they only accept the partial Listener set that contains no Conflicted Listeners. To put this another way, implementations may accept a partial Listener set only if they throw out *all* the conflicting Listeners. No picking one of the conflicting listeners as the winner. This also means that the Gateway must have at least one non-conflicting Listener in this case, otherwise it violates the requirement that at least one Listener must be present.\n\nThe implementation MUST set a \"ListenersNotValid\" condition on the Gateway Status when the Gateway contains Conflicted Listeners whether or not they accept the Gateway. That Condition SHOULD clearly indicate in the Message which Listeners are conflicted, and which are Accepted. Additionally, the Listener status for those listeners SHOULD indicate which Listeners are conflicted and not Accepted.\n\nA Gateway's Listeners are considered \"compatible\" if:\n\n1. They are distinct. 2. The implementation can serve them in compliance with the Addresses\n   requirement that all Listeners are available on all assigned\n   addresses.\n\nCompatible combinations in Extended support are expected to vary across implementations. A combination that is compatible for one implementation may not be compatible for another.\n\nFor example, an implementation that cannot serve both TCP and UDP listeners on the same address, or cannot mix HTTPS and generic TLS listens on the same port would not consider those cases compatible, even though they are distinct.\n\nNote that requests SHOULD match at most one Listener. For example, if Listeners are defined for \"foo.example.com\" and \"*.example.com\", a request to \"foo.example.com\" SHOULD only be routed using routes attached to the \"foo.example.com\" Listener (and not the \"*.example.com\" Listener). This concept is known as \"Listener Isolation\". Implementations that do not support Listener Isolation MUST clearly document this.\n\nImplementations MAY merge separate Gateways onto a single set of Addresses if all Listeners across all Gateways are compatible.\n\nSupport: Core",
+							Description: "Listeners associated with this Gateway. Listeners define logical endpoints that are bound on this Gateway's addresses. At least one Listener MUST be specified.\n\nEach Listener in a set of Listeners (for example, in a single Gateway) MUST be _distinct_, in that a traffic flow MUST be able to be assigned to exactly one listener. (This section uses \"set of Listeners\" rather than \"Listeners in a single Gateway\" because implementations MAY merge configuration from multiple Gateways onto a single data plane, and these rules _also_ apply in that case).\n\nPractically, this means that each listener in a set MUST have a unique combination of Port, Protocol, and, if supported by the protocol, Hostname.\n\nSome combinations of port, protocol, and TLS settings are considered Core support and MUST be supported by implementations based on their targeted conformance profile:\n\nHTTP Profile\n\n1. HTTPRoute, Port: 80, Protocol: HTTP 2. HTTPRoute, Port: 443, Protocol: HTTPS, TLS Mode: Terminate, TLS keypair provided\n\nTLS Profile\n\n1. TLSRoute, Port: 443, Protocol: TLS, TLS Mode: Passthrough\n\n\"Distinct\" Listeners have the following property:\n\nThe implementation can match inbound requests to a single distinct Listener. When multiple Listeners share values for fields (for example, two Listeners with the same Port value), the implementation can match requests to only one of the Listeners using other Listener fields.\n\nFor example, the following Listener scenarios are distinct:\n\n1. Multiple Listeners with the same Port that all use the \"HTTP\"\n   Protocol that all have unique Hostname values.\n2. Multiple Listeners with the same Port that use either the \"HTTPS\" or\n   \"TLS\" Protocol that all have unique Hostname values.\n3. A mixture of \"TCP\" and \"UDP\" Protocol Listeners, where no Listener\n   with the same Protocol has the same Port value.\n\nSome fields in the Listener struct have possible values that affect whether the Listener is distinct. Hostname is particularly relevant for HTTP or HTTPS protocols.\n\nWhen using the Hostname value to select between same-Port, same-Protocol Listeners, the Hostname value must be different on each Listener for the Listener to be distinct.\n\nWhen the Listeners are distinct based on Hostname, inbound request hostnames MUST match from the most specific to least specific Hostname values to choose the correct Listener and its associated set of Routes.\n\nExact matches must be processed before wildcard matches, and wildcard matches must be processed before fallback (empty Hostname value) matches. For example, `\"foo.example.com\"` takes precedence over `\"*.example.com\"`, and `\"*.example.com\"` takes precedence over `\"\"`.\n\nAdditionally, if there are multiple wildcard entries, more specific wildcard entries must be processed before less specific wildcard entries. For example, `\"*.foo.example.com\"` takes precedence over `\"*.example.com\"`. The precise definition here is that the higher the number of dots in the hostname to the right of the wildcard character, the higher the precedence.\n\nThe wildcard character will match any number of characters _and dots_ to the left, however, so `\"*.example.com\"` will match both `\"foo.bar.example.com\"` _and_ `\"bar.example.com\"`.\n\nIf a set of Listeners contains Listeners that are not distinct, then those Listeners are Conflicted, and the implementation MUST set the \"Conflicted\" condition in the Listener Status to \"True\".\n\nImplementations MAY choose to accept a Gateway with some Conflicted Listeners only if they only accept the partial Listener set that contains no Conflicted Listeners. To put this another way, implementations may accept a partial Listener set only if they throw out *all* the conflicting Listeners. No picking one of the conflicting listeners as the winner. This also means that the Gateway must have at least one non-conflicting Listener in this case, otherwise it violates the requirement that at least one Listener must be present.\n\nThe implementation MUST set a \"ListenersNotValid\" condition on the Gateway Status when the Gateway contains Conflicted Listeners whether or not they accept the Gateway. That Condition SHOULD clearly indicate in the Message which Listeners are conflicted, and which are Accepted. Additionally, the Listener status for those listeners SHOULD indicate which Listeners are conflicted and not Accepted.\n\nA Gateway's Listeners are considered \"compatible\" if:\n\n1. They are distinct. 2. The implementation can serve them in compliance with the Addresses\n   requirement that all Listeners are available on all assigned\n   addresses.\n\nCompatible combinations in Extended support are expected to vary across implementations. A combination that is compatible for one implementation may not be compatible for another.\n\nFor example, an implementation that cannot serve both TCP and UDP listeners on the same address, or cannot mix HTTPS and generic TLS listens on the same port would not consider those cases compatible, even though they are distinct.\n\nNote that requests SHOULD match at most one Listener. For example, if Listeners are defined for \"foo.example.com\" and \"*.example.com\", a request to \"foo.example.com\" SHOULD only be routed using routes attached to the \"foo.example.com\" Listener (and not the \"*.example.com\" Listener). This concept is known as \"Listener Isolation\". Implementations that do not support Listener Isolation MUST clearly document this.\n\nImplementations MAY merge separate Gateways onto a single set of Addresses if all Listeners across all Gateways are compatible.\n\n<gateway:experimental:description> Listeners with the HTTPS or TLS protocol must specify tls. </gateway:experimental:description>\n\nSupport: Core\n\n<gateway:experimental:validation:XValidation:message=\"tls must be specified for protocols ['HTTPS', 'TLS']\",rule=\"self.all(l, l.protocol in ['HTTPS', 'TLS'] ? has(l.tls) : true)\">",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3933,11 +4122,17 @@ func schema_sigsk8sio_gateway_api_apis_v1_GatewayStatus(ref common.ReferenceCall
 							},
 						},
 					},
+					"networkStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkStatus provides details about the network infrastructure, such as a cloud load balancer, that was provisioned for this Gateway. The addresses of that infrastructure are reported in Addresses.\n\nSupport: Implementation-specific\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.GatewayNetworkStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/gateway-api/apis/v1.GatewayStatusAddress", "sigs.k8s.io/gateway-api/apis/v1.ListenerStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/gateway-api/apis/v1.GatewayNetworkStatus", "sigs.k8s.io/gateway-api/apis/v1.GatewayStatusAddress", "sigs.k8s.io/gateway-api/apis/v1.ListenerStatus"},
 	}
 }
 
@@ -3986,7 +4181,7 @@ func schema_sigsk8sio_gateway_api_apis_v1_GatewayTLSConfig(ref common.ReferenceC
 					},
 					"certificateRefs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertificateRefs contains a series of references to Kubernetes objects that contains TLS certificates and private keys. These certificates are used to establish a TLS handshake for requests that match the hostname of the associated listener.\n\nA single CertificateRef to a Kubernetes Secret has \"Core\" support. Implementations MAY choose to support attaching multiple certificates to a Listener, but this behavior is implementation-specific.\n\nReferences to a resource in different namespace are invalid UNLESS there is a ReferenceGrant in the target namespace that allows the certificate to be attached. If a ReferenceGrant does not allow this reference, the \"ResolvedRefs\" condition MUST be set to False for this listener with the \"RefNotPermitted\" reason.\n\nThis field is required to have at least one element when the mode is set to \"Terminate\" (default) and is optional otherwise.\n\nCertificateRefs can reference to standard Kubernetes resources, i.e. Secret, or implementation-specific custom resources.\n\nSupport: Core - A single reference to a Kubernetes Secret of type kubernetes.io/tls\n\nSupport: Implementation-specific (More than one reference or other resource types)",
+							Description: "CertificateRefs contains a series of references to Kubernetes objects that contains TLS certificates and private keys. These certificates are used to establish a TLS handshake for requests that match the hostname of the associated listener.\n\nA single CertificateRef to a Kubernetes Secret has \"Core\" support. Implementations MAY choose to support attaching multiple certificates to a Listener, but this behavior is implementation-specific.\n\nReferences to a resource in different namespace are invalid UNLESS there is a ReferenceGrant in the target namespace that allows the certificate to be attached. If a ReferenceGrant does not allow this reference, the \"ResolvedRefs\" condition MUST be set to False for this listener with the \"RefNotPermitted\" reason.\n\nThis field is required to have at least one element when the mode is set to \"Terminate\" (default) and is optional otherwise.\n\nCertificateRefs can reference to standard Kubernetes resources, i.e. Secret, or implementation-specific custom resources.\n\n<gateway:experimental:description> CertificateRefs can also reference a core Kubernetes ConfigMap, for certificates produced by tooling that can not write Secrets. The ConfigMap MUST contain the `tls.crt` and `tls.key` keys, otherwise the \"ResolvedRefs\" condition MUST be set to False for this listener with the \"InvalidCertificateRef\" reason. </gateway:experimental:description>\n\nSupport: Core - A single reference to a Kubernetes Secret of type kubernetes.io/tls\n\n<gateway:experimental:description> Support: Extended - A single reference to a Kubernetes ConfigMap </gateway:experimental:description>\n\nSupport: Implementation-specific (More than one reference or other resource types)\n\n<gateway:experimental:validation:XValidation:message=\"ConfigMap certificateRefs must use the core API group\",rule=\"self.all(ref, !has(ref.kind) || ref.kind != 'ConfigMap' || !has(ref.group) || ref.group == '')\">",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4080,7 +4275,7 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPBackendRef(ref common.ReferenceCal
 					},
 					"filters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filters defined at this level should be executed if and only if the request is being forwarded to the backend defined here.\n\nSupport: Implementation-specific (For broader support of filters, use the Filters field in HTTPRouteRule.)",
+							Description: "Filters defined at this level should be executed if and only if the request is being forwarded to the backend defined here.\n\nSupport: Implementation-specific (For broader support of filters, use the Filters field in HTTPRouteRule.)\n\n<gateway:experimental:validation:XValidation:message=\"RequestBodyLimit filter cannot be repeated\",rule=\"self.filter(f, f.type == 'RequestBodyLimit').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.requestBodyLimit must be nil if the filter.type is not RequestBodyLimit\",rule=\"self.all(f, !(has(f.requestBodyLimit) && f.type != 'RequestBodyLimit'))\"> <gateway:experimental:validation:XValidation:message=\"filter.requestBodyLimit must be specified for RequestBodyLimit filter.type\",rule=\"self.all(f, !(!has(f.requestBodyLimit) && f.type == 'RequestBodyLimit'))\"> <gateway:experimental:validation:XValidation:message=\"ResponseCompression filter cannot be repeated\",rule=\"self.filter(f, f.type == 'ResponseCompression').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.responseCompression must be nil if the filter.type is not ResponseCompression\",rule=\"self.all(f, !(has(f.responseCompression) && f.type != 'ResponseCompression'))\"> <gateway:experimental:validation:XValidation:message=\"filter.responseCompression must be specified for ResponseCompression filter.type\",rule=\"self.all(f, !(!has(f.responseCompression) && f.type == 'ResponseCompression'))\"> <gateway:experimental:validation:XValidation:message=\"JWTAuth filter cannot be repeated\",rule=\"self.filter(f, f.type == 'JWTAuth').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.jwtAuth must be nil if the filter.type is not JWTAuth\",rule=\"self.all(f, !(has(f.jwtAuth) && f.type != 'JWTAuth'))\"> <gateway:experimental:validation:XValidation:message=\"filter.jwtAuth must be specified for JWTAuth filter.type\",rule=\"self.all(f, !(!has(f.jwtAuth) && f.type == 'JWTAuth'))\"> <gateway:experimental:validation:XValidation:message=\"CircuitBreaker filter cannot be repeated\",rule=\"self.filter(f, f.type == 'CircuitBreaker').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.circuitBreaker must be nil if the filter.type is not CircuitBreaker\",rule=\"self.all(f, !(has(f.circuitBreaker) && f.type != 'CircuitBreaker'))\"> <gateway:experimental:validation:XValidation:message=\"filter.circuitBreaker must be specified for CircuitBreaker filter.type\",rule=\"self.all(f, !(!has(f.circuitBreaker) && f.type == 'CircuitBreaker'))\"> <gateway:experimental:validation:XValidation:message=\"ResponseCache filter cannot be repeated\",rule=\"self.filter(f, f.type == 'ResponseCache').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.responseCache must be nil if the filter.type is not ResponseCache\",rule=\"self.all(f, !(has(f.responseCache) && f.type != 'ResponseCache'))\"> <gateway:experimental:validation:XValidation:message=\"filter.responseCache must be specified for ResponseCache filter.type\",rule=\"self.all(f, !(!has(f.responseCache) && f.type == 'ResponseCache'))\"> <gateway:experimental:validation:XValidation:message=\"GRPCTranscoding filter cannot be repeated\",rule=\"self.filter(f, f.type == 'GRPCTranscoding').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.grpcTranscoding must be nil if the filter.type is not GRPCTranscoding\",rule=\"self.all(f, !(has(f.grpcTranscoding) && f.type != 'GRPCTranscoding'))\"> <gateway:experimental:validation:XValidation:message=\"filter.grpcTranscoding must be specified for GRPCTranscoding filter.type\",rule=\"self.all(f, !(!has(f.grpcTranscoding) && f.type == 'GRPCTranscoding'))\">",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4101,6 +4296,74 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPBackendRef(ref common.ReferenceCal
 	}
 }
 
+func schema_sigsk8sio_gateway_api_apis_v1_HTTPCircuitBreakerFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPCircuitBreakerFilter defines a filter that stops sending requests to a backend once it has failed MaxFailures times within WindowSeconds. A request fails when the backend cannot be reached or responds with a 5xx status code.\n\nWhen used in an HTTPRouteRule, each of the rule's BackendRefs is tracked separately. When used in an HTTPBackendRef, only that BackendRef is tracked.\n\nWhile the circuit of a backend is open, requests that would have been sent to it MUST be sent to the next BackendRef of the rule with a closed circuit, in the order the BackendRefs are listed, wrapping around to the start of the list, regardless of weight, including weight 0. This means that a BackendRef with a weight of 0 can be used as a dedicated failover target that never receives traffic directly. When no such BackendRef exists, the implementation MUST respond with an HTTP 503 (Service Unavailable) status code. After CooldownSeconds, the circuit is closed again.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFailures is the number of failed requests within WindowSeconds after which the circuit of a backend is opened.\n\nSupport: Extended",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"windowSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowSeconds is the length of the sliding window, in seconds, over which failures are counted.\n\nSupport: Extended",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cooldownSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CooldownSeconds is the time, in seconds, for which the circuit of a backend stays open before traffic is sent to it again.\n\nSupport: Extended",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"maxFailures", "windowSeconds", "cooldownSeconds"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_gateway_api_apis_v1_HTTPGRPCTranscodingFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPGRPCTranscodingFilter defines a filter that transcodes HTTP/JSON requests to gRPC requests, and gRPC responses back to HTTP/JSON responses, following the HTTP annotations (google.api.http) of the gRPC service definitions. Requests that do not map to a gRPC method are forwarded to the backend unchanged.\n\nBackends of a rule using this filter are expected to speak gRPC, for example by setting the \"kubernetes.io/h2c\" appProtocol on their Service port.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protoDescriptorRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProtoDescriptorRef references the object containing the compiled protocol buffer descriptor set of the gRPC services to transcode.\n\nSupport: Extended for a ConfigMap with the descriptor set stored under the \"descriptor.pb\" key in its binaryData\n\nSupport: Implementation-specific for other resource types",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.LocalObjectReference"),
+						},
+					},
+					"ignoreUnknownQueryParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IgnoreUnknownQueryParameters specifies whether query parameters that do not map to a field of the gRPC request message are ignored. When unset or false, requests with unknown query parameters MUST be rejected with an HTTP 400 (Bad Request) status code.\n\nSupport: Extended",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"protoDescriptorRef"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/gateway-api/apis/v1.LocalObjectReference"},
+	}
+}
+
 func schema_sigsk8sio_gateway_api_apis_v1_HTTPHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4247,6 +4510,65 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPHeaderMatch(ref common.ReferenceCa
 	}
 }
 
+func schema_sigsk8sio_gateway_api_apis_v1_HTTPJWTAuthFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPJWTAuthFilter defines a filter that authenticates requests using JSON Web Tokens (RFC 7519). Requests without a token, or with a token that is malformed, expired, not signed by a key from JWKSURI, not issued by Issuer, or not intended for one of the Audiences, MUST NOT be forwarded to the backend, and the implementation MUST respond with an HTTP 401 (Unauthorized) status code.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Issuer is the expected value of the \"iss\" claim of the token.\n\nSupport: Extended",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"audiences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audiences is the list of accepted values of the \"aud\" claim of the token. When unspecified, the \"aud\" claim is not checked.\n\nSupport: Extended",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"jwksUri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JWKSURI is the URI of the JSON Web Key Set (RFC 7517) containing the public keys used to verify the signature of the token.\n\nSupport: Extended",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HeaderName is the name of the request header that carries the token. When the header is \"Authorization\", the token MUST use the \"Bearer\" scheme (RFC 6750). When unspecified, \"Authorization\" is used.\n\nSupport: Extended",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forwardToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForwardToken specifies whether the header carrying the token is forwarded to the backend. When set to false, the header MUST be removed from the request before it is forwarded. When unspecified, the header is forwarded.\n\nSupport: Extended",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"issuer", "jwksUri"},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_gateway_api_apis_v1_HTTPPathMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4268,6 +4590,20 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPPathMatch(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"normalizeTrailingSlash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NormalizeTrailingSlash specifies whether a trailing slash is ignored when matching the request path. When set to true, an \"Exact\" match for `/foo` also matches a request for `/foo/`, and an \"Exact\" match for `/foo/` also matches a request for `/foo`. When unset or false, trailing slashes are handled as they are today.\n\nThis field is only honored for the \"Exact\" path match type; \"PathPrefix\" matches already ignore a trailing slash at an element boundary.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"caseInsensitive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaseInsensitive specifies whether the request path is matched against Value case-insensitively. When set to true, an \"Exact\" match for `/foo/bar` also matches a request for `/Foo/Bar`. When unset or false, paths are matched case-sensitively as required by RFC 9110.\n\nThis field is honored for the \"Exact\" and \"PathPrefix\" path match types.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4347,6 +4683,28 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPQueryParamMatch(ref common.Referen
 	}
 }
 
+func schema_sigsk8sio_gateway_api_apis_v1_HTTPRequestBodyLimitFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPRequestBodyLimitFilter defines a filter that rejects requests with a body larger than MaxBytes. Requests that exceed the limit MUST NOT be forwarded to the backend, and the implementation MUST respond with an HTTP 413 (Payload Too Large) status code. Requests without a body, or with a body of at most MaxBytes bytes, are not affected.\n\nBoth requests with a Content-Length header and requests using chunked transfer encoding are subject to the limit.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBytes is the maximum size of a request body in bytes.\n\nSupport: Extended",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"maxBytes"},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_gateway_api_apis_v1_HTTPRequestMirrorFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4361,6 +4719,13 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRequestMirrorFilter(ref common.Ref
 							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.BackendObjectReference"),
 						},
 					},
+					"percent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percent represents the percentage of requests that should be mirrored to BackendRef. Its minimum value is 0 (indicating 0% of requests) and its maximum value is 100 (indicating 100% of requests).\n\nIf unset, 100% of requests are mirrored.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"backendRef"},
 			},
@@ -4419,6 +4784,128 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRequestRedirectFilter(ref common.R
 	}
 }
 
+func schema_sigsk8sio_gateway_api_apis_v1_HTTPResponseCacheFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPResponseCacheFilter defines a filter that caches responses at the Gateway. While a cached response is fresh, matching requests MUST be served from the cache without being forwarded to the backend.\n\nResponses are cached per method, host, path, query and the values of the VaryHeaders of the request. Implementations MUST honor the Cache-Control and Vary headers of the response as described in RFC 9111.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is how long a response is considered fresh when it does not define its own freshness lifetime, for example through a Cache-Control max-age directive. When unspecified, the TTL is implementation-specific.\n\nSupport: Extended",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"varyHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaryHeaders is a list of request headers whose values are part of the cache key, in addition to the headers listed in the Vary header of the response.\n\nSupport: Extended",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"methods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Methods is the list of request methods whose responses may be cached. When unspecified, responses to GET and HEAD requests are cached.\n\nSupport: Extended",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"privateHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrivateHeaders is a list of response headers whose presence makes a response non-cacheable, for example \"Set-Cookie\".\n\nSupport: Extended",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"maxResponseBodyBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxResponseBodyBytes is the maximum size of a response body in bytes for the response to be cached. Larger responses are not cached. When unspecified, the maximum size is implementation-specific.\n\nSupport: Extended",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_gateway_api_apis_v1_HTTPResponseCompressionFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPResponseCompressionFilter defines a filter that compresses response bodies using one of the encodings accepted by the client.\n\nThe encoding is negotiated using the Accept-Encoding header of the request. When the request does not include an Accept-Encoding header, or none of the accepted encodings are configured in Encodings, the response MUST NOT be compressed by this filter. When a response is compressed, the implementation MUST set the Content-Encoding header to the selected encoding. Responses that already have a Content-Encoding header MUST NOT be compressed again.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"encodings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encodings is the list of encodings that may be used to compress responses, in order of preference. When unspecified, the implementation MUST use Gzip.\n\nSupport: Extended for Gzip\n\nSupport: Implementation-specific for Brotli and Deflate",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"minSizeBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinSizeBytes is the minimum size of a response body in bytes for it to be compressed. Smaller responses are sent uncompressed. When unspecified, the minimum size is implementation-specific.\n\nSupport: Extended",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"excludeContentTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludeContentTypes is a list of media types, such as \"image/png\", for which responses MUST NOT be compressed. Media types are compared case-insensitively against the Content-Type header of the response, ignoring any parameters.\n\nSupport: Extended",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_gateway_api_apis_v1_HTTPRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4469,6 +4956,42 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRoute(ref common.ReferenceCallback
 	}
 }
 
+func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteFallbackPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPRouteFallbackPolicy configures retrying a request on a different backend when the backend that was originally selected responds with one of the configured status codes.\n\nThe initial backend is selected using the usual weight-based selection. When that backend responds with one of the listed StatusCodes, the request MUST be retried on the next BackendRef in the order in which BackendRefs are listed, wrapping around to the start of the list, regardless of their weights. This means that a BackendRef with a weight of 0 can be used as a dedicated fallback that never receives traffic directly.\n\nA backend that cannot be reached, for example because the connection is refused, MUST be treated as if it responded with a 503 (Service Unavailable) status code.\n\nA backend MUST NOT receive more than one attempt for the same request. If all attempts are exhausted, the response from the last attempt MUST be returned to the client.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"statusCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatusCodes is the list of HTTP response status codes from a backend that trigger a retry on the next BackendRef.\n\nSupport: Extended",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the maximum number of times a request is retried on a different BackendRef. The number of retries is also limited by the number of BackendRefs in the rule, since each backend receives at most one attempt.\n\nSupport: Extended",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"statusCodes"},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4478,7 +5001,7 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteFilter(ref common.ReferenceCa
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type identifies the type of filter to apply. As with other API fields, types are classified into three conformance levels:\n\n- Core: Filter types and their corresponding configuration defined by\n  \"Support: Core\" in this package, e.g. \"RequestHeaderModifier\". All\n  implementations must support core filters.\n\n- Extended: Filter types and their corresponding configuration defined by\n  \"Support: Extended\" in this package, e.g. \"RequestMirror\". Implementers\n  are encouraged to support extended filters.\n\n- Implementation-specific: Filters that are defined and supported by\n  specific vendors.\n  In the future, filters showing convergence in behavior across multiple\n  implementations will be considered for inclusion in extended or core\n  conformance levels. Filter-specific configuration for such filters\n  is specified using the ExtensionRef field. `Type` should be set to\n  \"ExtensionRef\" for custom filters.\n\nImplementers are encouraged to define custom implementation types to extend the core API with implementation-specific behavior.\n\nIf a reference to a custom filter type cannot be resolved, the filter MUST NOT be skipped. Instead, requests that would have been processed by that filter MUST receive a HTTP error response.\n\nNote that values may be added to this enum, implementations must ensure that unknown values will not cause a crash.\n\nUnknown values here must result in the implementation setting the Accepted Condition for the Route to `status: False`, with a Reason of `UnsupportedValue`.",
+							Description: "Type identifies the type of filter to apply. As with other API fields, types are classified into three conformance levels:\n\n- Core: Filter types and their corresponding configuration defined by\n  \"Support: Core\" in this package, e.g. \"RequestHeaderModifier\". All\n  implementations must support core filters.\n\n- Extended: Filter types and their corresponding configuration defined by\n  \"Support: Extended\" in this package, e.g. \"RequestMirror\". Implementers\n  are encouraged to support extended filters.\n\n- Implementation-specific: Filters that are defined and supported by\n  specific vendors.\n  In the future, filters showing convergence in behavior across multiple\n  implementations will be considered for inclusion in extended or core\n  conformance levels. Filter-specific configuration for such filters\n  is specified using the ExtensionRef field. `Type` should be set to\n  \"ExtensionRef\" for custom filters.\n\nImplementers are encouraged to define custom implementation types to extend the core API with implementation-specific behavior.\n\nIf a reference to a custom filter type cannot be resolved, the filter MUST NOT be skipped. Instead, requests that would have been processed by that filter MUST receive a HTTP error response.\n\nNote that values may be added to this enum, implementations must ensure that unknown values will not cause a crash.\n\nUnknown values here must result in the implementation setting the Accepted Condition for the Route to `status: False`, with a Reason of `UnsupportedValue`.\n\n<gateway:experimental:validation:Enum=RequestHeaderModifier;ResponseHeaderModifier;RequestMirror;RequestRedirect;URLRewrite;ExtensionRef;RequestBodyLimit;ResponseCompression;JWTAuth;CircuitBreaker;ResponseCache;GRPCTranscoding>",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -4520,12 +5043,48 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteFilter(ref common.ReferenceCa
 							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.LocalObjectReference"),
 						},
 					},
+					"requestBodyLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestBodyLimit defines a schema for a filter that limits the size of request bodies.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.HTTPRequestBodyLimitFilter"),
+						},
+					},
+					"responseCompression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResponseCompression defines a schema for a filter that compresses response bodies.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.HTTPResponseCompressionFilter"),
+						},
+					},
+					"jwtAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JWTAuth defines a schema for a filter that authenticates requests using JSON Web Tokens.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.HTTPJWTAuthFilter"),
+						},
+					},
+					"circuitBreaker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CircuitBreaker defines a schema for a filter that stops sending traffic to failing backends.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.HTTPCircuitBreakerFilter"),
+						},
+					},
+					"responseCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResponseCache defines a schema for a filter that caches responses at the Gateway.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.HTTPResponseCacheFilter"),
+						},
+					},
+					"grpcTranscoding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GRPCTranscoding defines a schema for a filter that transcodes HTTP/JSON requests to gRPC.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.HTTPGRPCTranscodingFilter"),
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/gateway-api/apis/v1.HTTPHeaderFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPRequestMirrorFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPRequestRedirectFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPURLRewriteFilter", "sigs.k8s.io/gateway-api/apis/v1.LocalObjectReference"},
+			"sigs.k8s.io/gateway-api/apis/v1.HTTPCircuitBreakerFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPGRPCTranscodingFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPHeaderFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPJWTAuthFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPRequestBodyLimitFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPRequestMirrorFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPRequestRedirectFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPResponseCacheFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPResponseCompressionFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPURLRewriteFilter", "sigs.k8s.io/gateway-api/apis/v1.LocalObjectReference"},
 	}
 }
 
@@ -4642,6 +5201,13 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteMatch(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upgrade specifies a protocol upgrade matcher. When specified, this route will be matched only if the request asks to upgrade the connection to the specified protocol, as indicated by the \"Connection: Upgrade\" and \"Upgrade\" request headers. Requests that do not ask for an upgrade, or ask for a different protocol, do not match.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4657,6 +5223,13 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteRule(ref common.ReferenceCall
 				Description: "HTTPRouteRule defines semantics for matching an HTTP request based on conditions (matches), processing it (filters), and forwarding the request to an API object (backendRefs).",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the route rule. This name MUST be unique within a Route if it is set. Unlike the index of a rule, the name does not change when rules are reordered, so implementations can use it to identify a rule in status, logs and metrics.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"matches": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Matches define conditions used for matching the rule against incoming HTTP requests. Each match is independent, i.e. this rule will be matched if **any** one of the matches is satisfied.\n\nFor example, take the following matches configuration:\n\n``` matches: - path:\n    value: \"/foo\"\n  headers:\n  - name: \"version\"\n    value: \"v2\"\n- path:\n    value: \"/v2/foo\"\n```\n\nFor a request to match against this rule, a request must satisfy EITHER of the two conditions:\n\n- path prefixed with `/foo` AND contains the header `version: v2` - path prefix of `/v2/foo`\n\nSee the documentation for HTTPRouteMatch on how to specify multiple match conditions that should be ANDed together.\n\nIf no matches are specified, the default is a prefix path match on \"/\", which has the effect of matching every HTTP request.\n\nProxy or Load Balancer routing configuration generated from HTTPRoutes MUST prioritize matches based on the following criteria, continuing on ties. Across all rules specified on applicable Routes, precedence must be given to the match having:\n\n* \"Exact\" path match. * \"Prefix\" path match with largest number of characters. * Method match. * Largest number of header matches. * Largest number of query param matches.\n\nNote: The precedence of RegularExpression path matches are implementation-specific.\n\nIf ties still exist across multiple Routes, matching precedence MUST be determined in order of the following criteria, continuing on ties:\n\n* The oldest Route based on creation timestamp. * The Route appearing first in alphabetical order by\n  \"{namespace}/{name}\".\n\nIf ties still exist within an HTTPRoute, matching precedence MUST be granted to the FIRST matching rule (in list order) with a match meeting the above criteria.\n\nWhen no rules matching a request have been successfully attached to the parent a request is coming from, a HTTP 404 status code MUST be returned.",
@@ -4673,7 +5246,7 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteRule(ref common.ReferenceCall
 					},
 					"filters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filters define the filters that are applied to requests that match this rule.\n\nWherever possible, implementations SHOULD implement filters in the order they are specified.\n\nImplementations MAY choose to implement this ordering strictly, rejecting any combination or order of filters that can not be supported. If implementations choose a strict interpretation of filter ordering, they MUST clearly document that behavior.\n\nTo reject an invalid combination or order of filters, implementations SHOULD consider the Route Rules with this configuration invalid. If all Route Rules in a Route are invalid, the entire Route would be considered invalid. If only a portion of Route Rules are invalid, implementations MUST set the \"PartiallyInvalid\" condition for the Route.\n\nConformance-levels at this level are defined based on the type of filter:\n\n- ALL core filters MUST be supported by all implementations. - Implementers are encouraged to support extended filters. - Implementation-specific custom filters have no API guarantees across\n  implementations.\n\nSpecifying the same filter multiple times is not supported unless explicitly indicated in the filter.\n\nAll filters are expected to be compatible with each other except for the URLRewrite and RequestRedirect filters, which may not be combined. If an implementation can not support other combinations of filters, they must clearly document that limitation. In cases where incompatible or unsupported filters are specified and cause the `Accepted` condition to be set to status `False`, implementations may use the `IncompatibleFilters` reason to specify this configuration error.\n\nSupport: Core",
+							Description: "Filters define the filters that are applied to requests that match this rule.\n\nWherever possible, implementations SHOULD implement filters in the order they are specified.\n\nImplementations MAY choose to implement this ordering strictly, rejecting any combination or order of filters that can not be supported. If implementations choose a strict interpretation of filter ordering, they MUST clearly document that behavior.\n\nTo reject an invalid combination or order of filters, implementations SHOULD consider the Route Rules with this configuration invalid. If all Route Rules in a Route are invalid, the entire Route would be considered invalid. If only a portion of Route Rules are invalid, implementations MUST set the \"PartiallyInvalid\" condition for the Route.\n\nConformance-levels at this level are defined based on the type of filter:\n\n- ALL core filters MUST be supported by all implementations. - Implementers are encouraged to support extended filters. - Implementation-specific custom filters have no API guarantees across\n  implementations.\n\nSpecifying the same filter multiple times is not supported unless explicitly indicated in the filter.\n\nAll filters are expected to be compatible with each other except for the URLRewrite and RequestRedirect filters, which may not be combined. If an implementation can not support other combinations of filters, they must clearly document that limitation. In cases where incompatible or unsupported filters are specified and cause the `Accepted` condition to be set to status `False`, implementations may use the `IncompatibleFilters` reason to specify this configuration error.\n\nSupport: Core\n\n<gateway:experimental:validation:XValidation:message=\"RequestBodyLimit filter cannot be repeated\",rule=\"self.filter(f, f.type == 'RequestBodyLimit').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.requestBodyLimit must be nil if the filter.type is not RequestBodyLimit\",rule=\"self.all(f, !(has(f.requestBodyLimit) && f.type != 'RequestBodyLimit'))\"> <gateway:experimental:validation:XValidation:message=\"filter.requestBodyLimit must be specified for RequestBodyLimit filter.type\",rule=\"self.all(f, !(!has(f.requestBodyLimit) && f.type == 'RequestBodyLimit'))\"> <gateway:experimental:validation:XValidation:message=\"ResponseCompression filter cannot be repeated\",rule=\"self.filter(f, f.type == 'ResponseCompression').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.responseCompression must be nil if the filter.type is not ResponseCompression\",rule=\"self.all(f, !(has(f.responseCompression) && f.type != 'ResponseCompression'))\"> <gateway:experimental:validation:XValidation:message=\"filter.responseCompression must be specified for ResponseCompression filter.type\",rule=\"self.all(f, !(!has(f.responseCompression) && f.type == 'ResponseCompression'))\"> <gateway:experimental:validation:XValidation:message=\"JWTAuth filter cannot be repeated\",rule=\"self.filter(f, f.type == 'JWTAuth').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.jwtAuth must be nil if the filter.type is not JWTAuth\",rule=\"self.all(f, !(has(f.jwtAuth) && f.type != 'JWTAuth'))\"> <gateway:experimental:validation:XValidation:message=\"filter.jwtAuth must be specified for JWTAuth filter.type\",rule=\"self.all(f, !(!has(f.jwtAuth) && f.type == 'JWTAuth'))\"> <gateway:experimental:validation:XValidation:message=\"CircuitBreaker filter cannot be repeated\",rule=\"self.filter(f, f.type == 'CircuitBreaker').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.circuitBreaker must be nil if the filter.type is not CircuitBreaker\",rule=\"self.all(f, !(has(f.circuitBreaker) && f.type != 'CircuitBreaker'))\"> <gateway:experimental:validation:XValidation:message=\"filter.circuitBreaker must be specified for CircuitBreaker filter.type\",rule=\"self.all(f, !(!has(f.circuitBreaker) && f.type == 'CircuitBreaker'))\"> <gateway:experimental:validation:XValidation:message=\"ResponseCache filter cannot be repeated\",rule=\"self.filter(f, f.type == 'ResponseCache').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.responseCache must be nil if the filter.type is not ResponseCache\",rule=\"self.all(f, !(has(f.responseCache) && f.type != 'ResponseCache'))\"> <gateway:experimental:validation:XValidation:message=\"filter.responseCache must be specified for ResponseCache filter.type\",rule=\"self.all(f, !(!has(f.responseCache) && f.type == 'ResponseCache'))\"> <gateway:experimental:validation:XValidation:message=\"GRPCTranscoding filter cannot be repeated\",rule=\"self.filter(f, f.type == 'GRPCTranscoding').size() <= 1\"> <gateway:experimental:validation:XValidation:message=\"filter.grpcTranscoding must be nil if the filter.type is not GRPCTranscoding\",rule=\"self.all(f, !(has(f.grpcTranscoding) && f.type != 'GRPCTranscoding'))\"> <gateway:experimental:validation:XValidation:message=\"filter.grpcTranscoding must be specified for GRPCTranscoding filter.type\",rule=\"self.all(f, !(!has(f.grpcTranscoding) && f.type == 'GRPCTranscoding'))\">",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4687,7 +5260,7 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteRule(ref common.ReferenceCall
 					},
 					"backendRefs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BackendRefs defines the backend(s) where matching requests should be sent.\n\nFailure behavior here depends on how many BackendRefs are specified and how many are invalid.\n\nIf *all* entries in BackendRefs are invalid, and there are also no filters specified in this route rule, *all* traffic which matches this rule MUST receive a 500 status code.\n\nSee the HTTPBackendRef definition for the rules about what makes a single HTTPBackendRef invalid.\n\nWhen a HTTPBackendRef is invalid, 500 status codes MUST be returned for requests that would have otherwise been routed to an invalid backend. If multiple backends are specified, and some are invalid, the proportion of requests that would otherwise have been routed to an invalid backend MUST receive a 500 status code.\n\nFor example, if two backends are specified with equal weights, and one is invalid, 50 percent of traffic must receive a 500. Implementations may choose how that 50 percent is determined.\n\nSupport: Core for Kubernetes Service\n\nSupport: Extended for Kubernetes ServiceImport\n\nSupport: Implementation-specific for any other resource\n\nSupport for weight: Core",
+							Description: "BackendRefs defines the backend(s) where matching requests should be sent.\n\nFailure behavior here depends on how many BackendRefs are specified and how many are invalid.\n\nIf *all* entries in BackendRefs are invalid, and there are also no filters specified in this route rule, *all* traffic which matches this rule MUST receive a 500 status code.\n\nSee the HTTPBackendRef definition for the rules about what makes a single HTTPBackendRef invalid.\n\nWhen a HTTPBackendRef is invalid, 500 status codes MUST be returned for requests that would have otherwise been routed to an invalid backend. If multiple backends are specified, and some are invalid, the proportion of requests that would otherwise have been routed to an invalid backend MUST receive a 500 status code.\n\nFor example, if two backends are specified with equal weights, and one is invalid, 50 percent of traffic must receive a 500. Implementations may choose how that 50 percent is determined.\n\n<gateway:experimental:description> When BackendRefs are specified, at least one of them must have a non-zero weight. </gateway:experimental:description>\n\nSupport: Core for Kubernetes Service\n\nSupport: Extended for Kubernetes ServiceImport\n\nSupport: Implementation-specific for any other resource\n\nSupport for weight: Core\n\n<gateway:experimental:validation:XValidation:message=\"at least one backendRef must have a non-zero weight\",rule=\"self.size() == 0 || self.exists(b, !has(b.weight) || b.weight != 0)\">",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4711,11 +5284,38 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteRule(ref common.ReferenceCall
 							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.SessionPersistence"),
 						},
 					},
+					"fallbackPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FallbackPolicy defines how requests are retried on other backends when the selected backend responds with a server error.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.HTTPRouteFallbackPolicy"),
+						},
+					},
+					"backendOverrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackendOverrides temporarily override the weights of BackendRefs in this rule without modifying the BackendRefs themselves, for example to shift traffic away from a degraded backend. Each override applies to every BackendRef in this rule that references the same backend, which means its group, kind, namespace, name and port all match. An unset namespace refers to the namespace of the Route on both sides. Overrides that do not match any BackendRef are ignored.\n\nOverrides are not subject to the requirement that at least one BackendRef has a non-zero weight. When the overrides leave every BackendRef in this rule with a weight of 0, all traffic which matches this rule MUST receive a 500 status code.\n\nImplementations that apply these overrides identify themselves through the ControllerName of the corresponding RouteParentStatus.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/gateway-api/apis/v1.BackendWeightOverride"),
+									},
+								},
+							},
+						},
+					},
+					"autoWeightAdjustment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoWeightAdjustment instructs the implementation to reduce the effective weight of BackendRefs it has determined to be unhealthy, for example through active or passive health checks. How the health of a backend is determined is implementation-specific.\n\nWhen enabled, implementations SHOULD adjust weights as follows:\n\n* After 2 consecutive failed health checks, the effective weight of the\n  backend is reduced by 50%.\n* Each further consecutive failure reduces the effective weight by\n  another 50%, down to a minimum effective weight of 1.\n* A single passing health check restores the configured weight.\n\nWhile any effective weight differs from its configured weight, implementations SHOULD set the \"AutoWeightAdjustmentActive\" condition to true on the corresponding RouteParentStatus.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/gateway-api/apis/v1.HTTPBackendRef", "sigs.k8s.io/gateway-api/apis/v1.HTTPRouteFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPRouteMatch", "sigs.k8s.io/gateway-api/apis/v1.HTTPRouteTimeouts", "sigs.k8s.io/gateway-api/apis/v1.SessionPersistence"},
+			"sigs.k8s.io/gateway-api/apis/v1.BackendWeightOverride", "sigs.k8s.io/gateway-api/apis/v1.HTTPBackendRef", "sigs.k8s.io/gateway-api/apis/v1.HTTPRouteFallbackPolicy", "sigs.k8s.io/gateway-api/apis/v1.HTTPRouteFilter", "sigs.k8s.io/gateway-api/apis/v1.HTTPRouteMatch", "sigs.k8s.io/gateway-api/apis/v1.HTTPRouteTimeouts", "sigs.k8s.io/gateway-api/apis/v1.SessionPersistence"},
 	}
 }
 
@@ -4755,9 +5355,16 @@ func schema_sigsk8sio_gateway_api_apis_v1_HTTPRouteSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority is an explicit precedence for this HTTPRoute relative to other HTTPRoutes with conflicting matches. A lower value indicates a higher priority, so a Route with priority 0 takes precedence over a Route with priority 10.\n\nWhen Priority is set, it takes precedence over the tie-breaking rules defined for HTTPRouteMatches, and Routes that set a Priority take precedence over Routes that do not. When Priority is unset, or multiple Routes specify the same Priority, the existing tie-breaking rules (most specific match, then oldest Route, then alphabetical order of \"{namespace}/{name}\") apply.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"rules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rules are a list of HTTP matchers, filters and actions.",
+							Description: "Rules are a list of HTTP matchers, filters and actions.\n\n<gateway:experimental:validation:XValidation:message=\"Rule name must be unique within the route\",rule=\"self.all(l1, !has(l1.name) || self.exists_one(l2, has(l2.name) && l1.name == l2.name))\">",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4912,6 +5519,13 @@ func schema_sigsk8sio_gateway_api_apis_v1_Listener(ref common.ReferenceCallback)
 							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.AllowedRoutes"),
 						},
 					},
+					"maxConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnections is the maximum number of concurrent client connections accepted by this Listener. When the limit is reached, implementations MUST respond to new requests with an HTTP 503 (Service Unavailable) status code for HTTP and HTTPS listeners, and MUST close new connections for TCP and TLS listeners. While the limit is reached, implementations SHOULD set the \"ConnectionsThrottled\" condition to true on the Listener status. When unspecified, the number of connections is only limited by the implementation.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"name", "port", "protocol"},
 			},
@@ -5313,6 +5927,20 @@ func schema_sigsk8sio_gateway_api_apis_v1_RouteParentStatus(ref common.Reference
 							},
 						},
 					},
+					"resolvedBackends": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolvedBackends is the number of BackendRefs of the Route that were successfully resolved by this parent. When it is lower than TotalBackends, the \"ResolvedRefs\" condition MUST be set to false.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalBackends": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBackends is the number of BackendRefs of the Route considered by this parent.\n\nSupport: Extended\n\n<gateway:experimental>",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"parentRef", "controllerName"},
 			},
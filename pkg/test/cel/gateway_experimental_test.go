@@ -0,0 +1,282 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateGatewayExperimental(t *testing.T) {
+	baseGateway := gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: "foo",
+			Listeners: []gatewayv1.Listener{
+				{
+					Name:     gatewayv1.SectionName("http"),
+					Protocol: gatewayv1.HTTPProtocolType,
+					Port:     gatewayv1.PortNumber(80),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc       string
+		mutate     func(gw *gatewayv1.Gateway)
+		wantErrors []string
+	}{
+		{
+			desc: "HTTPS listener without tls",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners = []gatewayv1.Listener{
+					{
+						Name:     gatewayv1.SectionName("https"),
+						Protocol: gatewayv1.HTTPSProtocolType,
+						Port:     gatewayv1.PortNumber(443),
+					},
+				}
+			},
+			wantErrors: []string{"tls must be specified for protocols ['HTTPS', 'TLS']"},
+		},
+		{
+			desc: "TLS listener without tls",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners = []gatewayv1.Listener{
+					{
+						Name:     gatewayv1.SectionName("tls"),
+						Protocol: gatewayv1.TLSProtocolType,
+						Port:     gatewayv1.PortNumber(443),
+					},
+				}
+			},
+			wantErrors: []string{"tls must be specified for protocols ['HTTPS', 'TLS']"},
+		},
+		{
+			desc: "listener maxConnections within range",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners[0].MaxConnections = ptrTo(int64(1024))
+			},
+		},
+		{
+			desc: "listener maxConnections of zero",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners[0].MaxConnections = ptrTo(int64(0))
+			},
+			wantErrors: []string{"should be greater than or equal to 1"},
+		},
+		{
+			desc: "certificateRefs set to a core ConfigMap",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners = []gatewayv1.Listener{
+					{
+						Name:     gatewayv1.SectionName("https"),
+						Protocol: gatewayv1.HTTPSProtocolType,
+						Port:     gatewayv1.PortNumber(8443),
+						TLS: &gatewayv1.GatewayTLSConfig{
+							CertificateRefs: []gatewayv1.SecretObjectReference{
+								{
+									Group: ptrTo(gatewayv1.Group("")),
+									Kind:  ptrTo(gatewayv1.Kind("ConfigMap")),
+									Name:  gatewayv1.ObjectName("foo"),
+								},
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "certificateRefs set to a ConfigMap outside of the core API group",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners = []gatewayv1.Listener{
+					{
+						Name:     gatewayv1.SectionName("https"),
+						Protocol: gatewayv1.HTTPSProtocolType,
+						Port:     gatewayv1.PortNumber(8443),
+						TLS: &gatewayv1.GatewayTLSConfig{
+							CertificateRefs: []gatewayv1.SecretObjectReference{
+								{
+									Group: ptrTo(gatewayv1.Group("example.com")),
+									Kind:  ptrTo(gatewayv1.Kind("ConfigMap")),
+									Name:  gatewayv1.ObjectName("foo"),
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"ConfigMap certificateRefs must use the core API group"},
+		},
+		{
+			desc: "valid resourceAnnotations keys",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1.GatewayInfrastructure{
+					ResourceAnnotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+						"foo":                                    "bar",
+						"example.com/foo":                        "bar",
+						"service.beta.kubernetes.io/lb-type.123": "nlb",
+					},
+				}
+			},
+		},
+		{
+			desc: "resourceAnnotations key with an uppercase prefix",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1.GatewayInfrastructure{
+					ResourceAnnotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+						"Example.com/foo": "bar",
+					},
+				}
+			},
+			wantErrors: []string{"resourceAnnotations keys must be valid annotation keys"},
+		},
+		{
+			desc: "resourceAnnotations key with an empty name",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1.GatewayInfrastructure{
+					ResourceAnnotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+						"example.com/": "bar",
+					},
+				}
+			},
+			wantErrors: []string{"resourceAnnotations keys must be valid annotation keys"},
+		},
+		{
+			desc: "too many resourceAnnotations",
+			mutate: func(gw *gatewayv1.Gateway) {
+				annotations := map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{}
+				for i := 0; i < 64; i++ {
+					annotations[gatewayv1.AnnotationKey(fmt.Sprintf("example.com/key-%d", i))] = "value"
+				}
+				gw.Spec.Infrastructure = &gatewayv1.GatewayInfrastructure{
+					ResourceAnnotations: annotations,
+				}
+			},
+			wantErrors: []string{"spec.infrastructure.resourceAnnotations: Too many: 64: must have at most 63 items"},
+		},
+		{
+			desc: "allowedRoutes selector with matchLabels",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners[0].AllowedRoutes = &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{
+						From: ptrTo(gatewayv1.NamespacesFromSelector),
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"kubernetes.io/metadata.name": "foo"},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "allowedRoutes selector with matchExpressions",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners[0].AllowedRoutes = &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{
+						From: ptrTo(gatewayv1.NamespacesFromSelector),
+						Selector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{{
+								Key:      "env",
+								Operator: metav1.LabelSelectorOpExists,
+							}},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "allowedRoutes with an empty selector",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners[0].AllowedRoutes = &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{
+						From:     ptrTo(gatewayv1.NamespacesFromSelector),
+						Selector: &metav1.LabelSelector{},
+					},
+				}
+			},
+			wantErrors: []string{"selector must not be empty when from is Selector"},
+		},
+		{
+			desc: "allowedRoutes from Selector without a selector",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners[0].AllowedRoutes = &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{
+						From: ptrTo(gatewayv1.NamespacesFromSelector),
+					},
+				}
+			},
+			wantErrors: []string{"selector must not be empty when from is Selector"},
+		},
+		{
+			desc: "allowedRoutes from All with an empty selector",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners[0].AllowedRoutes = &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{
+						From:     ptrTo(gatewayv1.NamespacesFromAll),
+						Selector: &metav1.LabelSelector{},
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			gw := baseGateway.DeepCopy()
+			gw.Name = fmt.Sprintf("foo-%v", time.Now().UnixNano())
+
+			if tc.mutate != nil {
+				tc.mutate(gw)
+			}
+			validateGateway(t, gw, tc.wantErrors)
+		})
+	}
+}
+
+func validateGateway(t *testing.T, gw *gatewayv1.Gateway, wantErrors []string) {
+	t.Helper()
+
+	ctx := context.Background()
+	err := k8sClient.Create(ctx, gw)
+
+	if (len(wantErrors) != 0) != (err != nil) {
+		t.Fatalf("Unexpected response while creating Gateway %q; got err=\n%v\n;want error=%v", fmt.Sprintf("%v/%v", gw.Namespace, gw.Name), err, wantErrors)
+	}
+
+	var missingErrorStrings []string
+	for _, wantError := range wantErrors {
+		if !strings.Contains(strings.ToLower(err.Error()), strings.ToLower(wantError)) {
+			missingErrorStrings = append(missingErrorStrings, wantError)
+		}
+	}
+	if len(missingErrorStrings) != 0 {
+		t.Errorf("Unexpected response while creating Gateway %q; got err=\n%v\n;missing strings within error=%q", fmt.Sprintf("%v/%v", gw.Namespace, gw.Name), err, missingErrorStrings)
+	}
+}
@@ -114,30 +114,6 @@ func TestValidateGateway(t *testing.T) {
 			},
 			wantErrors: []string{"tls must not be specified for protocols ['HTTP', 'TCP', 'UDP']"},
 		},
-		{
-			desc: "tls config not set with https protocol",
-			mutate: func(gw *gatewayv1.Gateway) {
-				gw.Spec.Listeners = []gatewayv1.Listener{
-					{
-						Name:     gatewayv1.SectionName("https"),
-						Protocol: gatewayv1.HTTPSProtocolType,
-						Port:     gatewayv1.PortNumber(8443),
-					},
-				}
-			},
-		},
-		{
-			desc: "tls config not set with tls protocol",
-			mutate: func(gw *gatewayv1.Gateway) {
-				gw.Spec.Listeners = []gatewayv1.Listener{
-					{
-						Name:     gatewayv1.SectionName("tls"),
-						Protocol: gatewayv1.TLSProtocolType,
-						Port:     gatewayv1.PortNumber(8443),
-					},
-				}
-			},
-		},
 		{
 			desc: "tls config not set with http protocol",
 			mutate: func(gw *gatewayv1.Gateway) {
@@ -585,6 +561,33 @@ func TestValidateGateway(t *testing.T) {
 			},
 			wantErrors: []string{"IPAddress values must be unique", "Hostname values must be unique"},
 		},
+		{
+			desc: "64 listeners",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners = nil
+				for i := 0; i < 64; i++ {
+					gw.Spec.Listeners = append(gw.Spec.Listeners, gatewayv1.Listener{
+						Name:     gatewayv1.SectionName(fmt.Sprintf("http-%d", i)),
+						Protocol: gatewayv1.HTTPProtocolType,
+						Port:     gatewayv1.PortNumber(8000 + i),
+					})
+				}
+			},
+		},
+		{
+			desc: "too many listeners",
+			mutate: func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners = nil
+				for i := 0; i < 65; i++ {
+					gw.Spec.Listeners = append(gw.Spec.Listeners, gatewayv1.Listener{
+						Name:     gatewayv1.SectionName(fmt.Sprintf("http-%d", i)),
+						Protocol: gatewayv1.HTTPProtocolType,
+						Port:     gatewayv1.PortNumber(8000 + i),
+					})
+				}
+			},
+			wantErrors: []string{"spec.listeners: Too many: 65: must have at most 64 items"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,121 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateGatewayClassRolloutStrategy(t *testing.T) {
+	ctx := context.Background()
+	baseGatewayClass := gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+		Spec: gatewayv1.GatewayClassSpec{
+			ControllerName: "example.net/gateway-controller",
+		},
+	}
+
+	testCases := []struct {
+		desc       string
+		mutate     func(gwc *gatewayv1.GatewayClass)
+		wantErrors []string
+	}{
+		{
+			desc: "Recreate rollout strategy",
+			mutate: func(gwc *gatewayv1.GatewayClass) {
+				gwc.Spec.RolloutStrategy = &gatewayv1.GatewayClassRolloutStrategy{
+					Type: gatewayv1.GatewayClassRolloutStrategyRecreate,
+				}
+			},
+		},
+		{
+			desc: "BlueGreen rollout strategy with canaryWeight",
+			mutate: func(gwc *gatewayv1.GatewayClass) {
+				gwc.Spec.RolloutStrategy = &gatewayv1.GatewayClassRolloutStrategy{
+					Type:         gatewayv1.GatewayClassRolloutStrategyBlueGreen,
+					CanaryWeight: ptrTo(int32(10)),
+				}
+			},
+		},
+		{
+			desc: "Recreate rollout strategy with canaryWeight",
+			mutate: func(gwc *gatewayv1.GatewayClass) {
+				gwc.Spec.RolloutStrategy = &gatewayv1.GatewayClassRolloutStrategy{
+					Type:         gatewayv1.GatewayClassRolloutStrategyRecreate,
+					CanaryWeight: ptrTo(int32(10)),
+				}
+			},
+			wantErrors: []string{"canaryWeight may only be set when type is BlueGreen"},
+		},
+		{
+			desc: "BlueGreen rollout strategy with canaryWeight out of range",
+			mutate: func(gwc *gatewayv1.GatewayClass) {
+				gwc.Spec.RolloutStrategy = &gatewayv1.GatewayClassRolloutStrategy{
+					Type:         gatewayv1.GatewayClassRolloutStrategyBlueGreen,
+					CanaryWeight: ptrTo(int32(101)),
+				}
+			},
+			wantErrors: []string{"should be less than or equal to 100"},
+		},
+		{
+			desc: "unknown rollout strategy type",
+			mutate: func(gwc *gatewayv1.GatewayClass) {
+				gwc.Spec.RolloutStrategy = &gatewayv1.GatewayClassRolloutStrategy{
+					Type: "Rolling",
+				}
+			},
+			wantErrors: []string{"Unsupported value"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			gwc := baseGatewayClass.DeepCopy()
+			gwc.Name = fmt.Sprintf("foo-%v", time.Now().UnixNano())
+
+			tc.mutate(gwc)
+			err := k8sClient.Create(ctx, gwc)
+
+			if (len(tc.wantErrors) != 0) != (err != nil) {
+				t.Fatalf("Unexpected response while creating GatewayClass; got err=\n%v\n;want error=%v", err, tc.wantErrors != nil)
+			}
+
+			var missingErrorStrings []string
+			for _, wantError := range tc.wantErrors {
+				if !strings.Contains(strings.ToLower(err.Error()), strings.ToLower(wantError)) {
+					missingErrorStrings = append(missingErrorStrings, wantError)
+				}
+			}
+			if len(missingErrorStrings) != 0 {
+				t.Errorf("Unexpected response while creating GatewayClass; got err=\n%v\n;missing strings within error=%q", err, missingErrorStrings)
+			}
+		})
+	}
+}
@@ -372,3 +372,375 @@ func TestHTTPRouteTimeouts(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPRouteRuleExperimental(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantErrors []string
+		rules      []gatewayv1.HTTPRouteRule
+	}{
+		{
+			name: "valid weighted backendRefs with a zero weight",
+			rules: []gatewayv1.HTTPRouteRule{{
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					{
+						BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name: "foo",
+								Port: ptrTo(gatewayv1.PortNumber(8080)),
+							},
+							Weight: ptrTo(int32(70)),
+						},
+					},
+					{
+						BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name: "bar",
+								Port: ptrTo(gatewayv1.PortNumber(8080)),
+							},
+							Weight: ptrTo(int32(0)),
+						},
+					},
+				},
+			}},
+		},
+		{
+			name:       "invalid because all backendRefs have a zero weight",
+			wantErrors: []string{"at least one backendRef must have a non-zero weight"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					{
+						BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name: "foo",
+								Port: ptrTo(gatewayv1.PortNumber(8080)),
+							},
+							Weight: ptrTo(int32(0)),
+						},
+					},
+					{
+						BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name: "bar",
+								Port: ptrTo(gatewayv1.PortNumber(8080)),
+							},
+							Weight: ptrTo(int32(0)),
+						},
+					},
+				},
+			}},
+		},
+		{
+			name: "valid fallbackPolicy",
+			rules: []gatewayv1.HTTPRouteRule{{
+				FallbackPolicy: &gatewayv1.HTTPRouteFallbackPolicy{
+					StatusCodes: []gatewayv1.HTTPRouteFallbackStatusCode{502, 503, 504},
+					MaxRetries:  ptrTo(int32(2)),
+				},
+			}},
+		},
+		{
+			name:       "invalid fallbackPolicy with a non-5xx status code",
+			wantErrors: []string{"spec.rules[0].fallbackPolicy.statusCodes[0] in body should be greater than or equal to 500"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				FallbackPolicy: &gatewayv1.HTTPRouteFallbackPolicy{
+					StatusCodes: []gatewayv1.HTTPRouteFallbackStatusCode{404},
+				},
+			}},
+		},
+		{
+			name:       "invalid fallbackPolicy with too many retries",
+			wantErrors: []string{"spec.rules[0].fallbackPolicy.maxRetries in body should be less than or equal to 15"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				FallbackPolicy: &gatewayv1.HTTPRouteFallbackPolicy{
+					StatusCodes: []gatewayv1.HTTPRouteFallbackStatusCode{503},
+					MaxRetries:  ptrTo(int32(16)),
+				},
+			}},
+		},
+		{
+			name: "valid backendOverrides",
+			rules: []gatewayv1.HTTPRouteRule{{
+				BackendOverrides: []gatewayv1.BackendWeightOverride{
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "foo", Port: ptrTo(gatewayv1.PortNumber(8080))}, Weight: 10},
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "bar", Port: ptrTo(gatewayv1.PortNumber(8080))}, Weight: 0},
+				},
+			}},
+		},
+		{
+			name: "valid backendOverrides with the same name in different namespaces",
+			rules: []gatewayv1.HTTPRouteRule{{
+				BackendOverrides: []gatewayv1.BackendWeightOverride{
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "foo", Port: ptrTo(gatewayv1.PortNumber(8080))}, Weight: 10},
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "foo", Namespace: ptrTo(gatewayv1.Namespace("other")), Port: ptrTo(gatewayv1.PortNumber(8080))}, Weight: 20},
+				},
+			}},
+		},
+		{
+			name:       "invalid backendOverrides with duplicate backends",
+			wantErrors: []string{"backendOverrides must be unique"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				BackendOverrides: []gatewayv1.BackendWeightOverride{
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "foo", Port: ptrTo(gatewayv1.PortNumber(8080))}, Weight: 10},
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "foo", Port: ptrTo(gatewayv1.PortNumber(8080))}, Weight: 20},
+				},
+			}},
+		},
+		{
+			name:       "invalid backendOverrides for a Service without a port",
+			wantErrors: []string{"Must have port for Service reference"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				BackendOverrides: []gatewayv1.BackendWeightOverride{
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "foo"}, Weight: 10},
+				},
+			}},
+		},
+		{
+			name: "valid RequestBodyLimit filter",
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type:             gatewayv1.HTTPRouteFilterRequestBodyLimit,
+					RequestBodyLimit: &gatewayv1.HTTPRequestBodyLimitFilter{MaxBytes: 1024},
+				}},
+			}},
+		},
+		{
+			name:       "invalid RequestBodyLimit filter with zero maxBytes",
+			wantErrors: []string{"spec.rules[0].filters[0].requestBodyLimit.maxBytes in body should be greater than or equal to 1"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type:             gatewayv1.HTTPRouteFilterRequestBodyLimit,
+					RequestBodyLimit: &gatewayv1.HTTPRequestBodyLimitFilter{MaxBytes: 0},
+				}},
+			}},
+		},
+		{
+			name:       "invalid RequestBodyLimit filter without requestBodyLimit",
+			wantErrors: []string{"filter.requestBodyLimit must be specified for RequestBodyLimit filter.type"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterRequestBodyLimit,
+				}},
+			}},
+		},
+		{
+			name:       "invalid requestBodyLimit with a different filter type",
+			wantErrors: []string{"filter.requestBodyLimit must be nil if the filter.type is not RequestBodyLimit"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gatewayv1.LocalObjectReference{
+						Group: "example.com",
+						Kind:  "Foo",
+						Name:  "foo",
+					},
+					RequestBodyLimit: &gatewayv1.HTTPRequestBodyLimitFilter{MaxBytes: 1024},
+				}},
+			}},
+		},
+		{
+			name: "valid ResponseCompression filter",
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterResponseCompression,
+					ResponseCompression: &gatewayv1.HTTPResponseCompressionFilter{
+						Encodings:           []gatewayv1.CompressionEncoding{gatewayv1.CompressionEncodingBrotli, gatewayv1.CompressionEncodingGzip},
+						MinSizeBytes:        ptrTo(int64(1024)),
+						ExcludeContentTypes: []string{"image/png"},
+					},
+				}},
+			}},
+		},
+		{
+			name:       "invalid ResponseCompression filter with duplicate encodings",
+			wantErrors: []string{"encodings must be unique"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterResponseCompression,
+					ResponseCompression: &gatewayv1.HTTPResponseCompressionFilter{
+						Encodings: []gatewayv1.CompressionEncoding{gatewayv1.CompressionEncodingGzip, gatewayv1.CompressionEncodingGzip},
+					},
+				}},
+			}},
+		},
+		{
+			name:       "invalid ResponseCompression filter without responseCompression",
+			wantErrors: []string{"filter.responseCompression must be specified for ResponseCompression filter.type"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterResponseCompression,
+				}},
+			}},
+		},
+		{
+			name:       "invalid repeated RequestBodyLimit filter",
+			wantErrors: []string{"RequestBodyLimit filter cannot be repeated"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{
+					{
+						Type:             gatewayv1.HTTPRouteFilterRequestBodyLimit,
+						RequestBodyLimit: &gatewayv1.HTTPRequestBodyLimitFilter{MaxBytes: 1024},
+					},
+					{
+						Type:             gatewayv1.HTTPRouteFilterRequestBodyLimit,
+						RequestBodyLimit: &gatewayv1.HTTPRequestBodyLimitFilter{MaxBytes: 2048},
+					},
+				},
+			}},
+		},
+		{
+			name: "valid JWTAuth filter",
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterJWTAuth,
+					JWTAuth: &gatewayv1.HTTPJWTAuthFilter{
+						Issuer:  "https://issuer.example.com",
+						JWKSURI: "https://issuer.example.com/.well-known/jwks.json",
+					},
+				}},
+			}},
+		},
+		{
+			name:       "invalid JWTAuth filter without jwtAuth",
+			wantErrors: []string{"filter.jwtAuth must be specified for JWTAuth filter.type"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterJWTAuth,
+				}},
+			}},
+		},
+		{
+			name:       "invalid JWTAuth filter with empty issuer",
+			wantErrors: []string{"should be at least 1 chars long"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterJWTAuth,
+					JWTAuth: &gatewayv1.HTTPJWTAuthFilter{
+						JWKSURI: "https://issuer.example.com/.well-known/jwks.json",
+					},
+				}},
+			}},
+		},
+		{
+			name: "valid CircuitBreaker filter",
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterCircuitBreaker,
+					CircuitBreaker: &gatewayv1.HTTPCircuitBreakerFilter{
+						MaxFailures:     5,
+						WindowSeconds:   10,
+						CooldownSeconds: 30,
+					},
+				}},
+			}},
+		},
+		{
+			name:       "invalid circuitBreaker set for a different filter type",
+			wantErrors: []string{"filter.circuitBreaker must be nil if the filter.type is not CircuitBreaker"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type:             gatewayv1.HTTPRouteFilterRequestBodyLimit,
+					RequestBodyLimit: &gatewayv1.HTTPRequestBodyLimitFilter{MaxBytes: 1024},
+					CircuitBreaker: &gatewayv1.HTTPCircuitBreakerFilter{
+						MaxFailures:     5,
+						WindowSeconds:   10,
+						CooldownSeconds: 30,
+					},
+				}},
+			}},
+		},
+		{
+			name: "valid ResponseCache filter",
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterResponseCache,
+					ResponseCache: &gatewayv1.HTTPResponseCacheFilter{
+						TTL:     ptrTo(gatewayv1.Duration("10m")),
+						Methods: []gatewayv1.HTTPMethod{gatewayv1.HTTPMethodGet},
+					},
+				}},
+			}},
+		},
+		{
+			name:       "invalid ResponseCache filter with zero ttl",
+			wantErrors: []string{"ttl must be greater than 0s"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterResponseCache,
+					ResponseCache: &gatewayv1.HTTPResponseCacheFilter{
+						TTL: ptrTo(gatewayv1.Duration("0s")),
+					},
+				}},
+			}},
+		},
+		{
+			name: "valid GRPCTranscoding filter",
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterGRPCTranscoding,
+					GRPCTranscoding: &gatewayv1.HTTPGRPCTranscodingFilter{
+						ProtoDescriptorRef: gatewayv1.LocalObjectReference{Group: "", Kind: "ConfigMap", Name: "echo-descriptor"},
+					},
+				}},
+			}},
+		},
+		{
+			name:       "invalid GRPCTranscoding filter with empty descriptor name",
+			wantErrors: []string{"should be at least 1 chars long"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterGRPCTranscoding,
+					GRPCTranscoding: &gatewayv1.HTTPGRPCTranscodingFilter{
+						ProtoDescriptorRef: gatewayv1.LocalObjectReference{Group: "", Kind: "ConfigMap"},
+					},
+				}},
+			}},
+		},
+		{
+			name: "valid Websocket upgrade match",
+			rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Upgrade: ptrTo(gatewayv1.HTTPUpgradeWebsocket),
+				}},
+			}},
+		},
+		{
+			name:       "invalid upgrade match type",
+			wantErrors: []string{"Unsupported value"},
+			rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Upgrade: ptrTo(gatewayv1.HTTPUpgradeType("h2c")),
+				}},
+			}},
+		},
+		{
+			name: "valid unique rule names",
+			rules: []gatewayv1.HTTPRouteRule{
+				{Name: ptrTo(gatewayv1.SectionName("foo"))},
+				{Name: ptrTo(gatewayv1.SectionName("bar"))},
+				{},
+			},
+		},
+		{
+			name:       "invalid repeated rule names",
+			wantErrors: []string{"Rule name must be unique within the route"},
+			rules: []gatewayv1.HTTPRouteRule{
+				{Name: ptrTo(gatewayv1.SectionName("foo"))},
+				{Name: ptrTo(gatewayv1.SectionName("foo"))},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("foo-%v", time.Now().UnixNano()),
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: gatewayv1.HTTPRouteSpec{Rules: tc.rules},
+			}
+			validateHTTPRoute(t, route, tc.wantErrors)
+		})
+	}
+}
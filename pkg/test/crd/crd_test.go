@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const crdDir = "../../../config/crd"
+
+func TestCRDCategories(t *testing.T) {
+	for _, channel := range []string{"standard", "experimental"} {
+		files, err := filepath.Glob(filepath.Join(crdDir, channel, "gateway.networking.k8s.io_*.yaml"))
+		if err != nil {
+			t.Fatalf("failed to list %s CRDs: %v", channel, err)
+		}
+		if len(files) == 0 {
+			t.Fatalf("no %s CRDs found in %s", channel, crdDir)
+		}
+
+		for _, file := range files {
+			t.Run(filepath.Join(channel, filepath.Base(file)), func(t *testing.T) {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatalf("failed to read CRD: %v", err)
+				}
+
+				crd := &apiextensionsv1.CustomResourceDefinition{}
+				if err := yaml.Unmarshal(data, crd); err != nil {
+					t.Fatalf("failed to unmarshal CRD: %v", err)
+				}
+
+				if !slices.Contains(crd.Spec.Names.Categories, "gateway-api") {
+					t.Errorf("CRD %s categories %v do not include gateway-api", crd.Name, crd.Spec.Names.Categories)
+				}
+			})
+		}
+	}
+}
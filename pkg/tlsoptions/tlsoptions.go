@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tlsoptions defines well-known keys for the Options map of
+// GatewayTLSConfig. Implementations are not required to support any of these
+// options, but are encouraged to use these keys rather than
+// implementation-specific ones when they support the equivalent behavior.
+//
+// As keys defined by Gateway API, they are un-prefixed; domain-prefixed
+// names remain reserved for implementation-specific options.
+//
+// These options apply to the TLS configuration of Gateway Listeners. There is
+// no equivalent options map for TLS towards backends, so backend TLS settings
+// such as these cannot currently be expressed through this package.
+package tlsoptions
+
+const (
+	// OptionALPNProtocols is the option key for the list of protocols
+	// advertised through Application-Layer Protocol Negotiation (RFC 7301).
+	// The value is a comma-separated list of ALPN protocol IDs in order of
+	// preference, for example "h2,http/1.1".
+	OptionALPNProtocols = "alpn-protocols"
+
+	// OptionSessionTickets is the option key that controls whether TLS
+	// session tickets (RFC 5077) are issued to clients. The value is either
+	// "true" or "false".
+	OptionSessionTickets = "session-tickets"
+
+	// OptionMinVersion is the option key for the minimum accepted TLS
+	// version. The value is one of "1.2" or "1.3".
+	OptionMinVersion = "min-version"
+
+	// OptionMaxVersion is the option key for the maximum accepted TLS
+	// version. The value is one of "1.2" or "1.3".
+	OptionMaxVersion = "max-version"
+
+	// OptionCipherSuites is the option key for the list of cipher suites
+	// accepted for TLS 1.2 connections. The value is a comma-separated list of
+	// IANA cipher suite names in order of preference, for example
+	// "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256".
+	OptionCipherSuites = "cipher-suites"
+)